@@ -0,0 +1,434 @@
+package main
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+	"sort"
+	"strings"
+)
+
+var CHECKS_HELP = []Help{helpUp, helpDown, helpRerequestCheckSuite, helpOpenCheck, helpBack}
+
+const (
+	CHECK_FAILING = 1
+	CHECK_PENDING = 2
+	CHECK_PASSING = 3
+	CHECK_SKIPPED = 4
+)
+
+// Check is either a check run of a check suite or a commit status context, whichever way CI reported it.
+type Check struct {
+	name  string
+	state int
+	label string
+	url   string
+	// checkSuiteId is empty for status contexts, which can not be re-requested.
+	checkSuiteId string
+	summary      string
+	annotations  []*CheckAnnotationFields
+}
+
+// mapChecks flattens check runs of all check suites and status contexts of the latest commit into a list of checks,
+// failing ones first.
+func mapChecks(repository *getPullRequestChecksRepository) []*Check {
+	checks := []*Check{}
+
+	commits := repository.GetPullRequest().GetCommits().GetNodes()
+	if len(commits) == 0 {
+		return checks
+	}
+	commit := commits[len(commits)-1].GetCommit()
+
+	for _, checkSuite := range commit.GetCheckSuites().GetNodes() {
+		for _, checkRun := range checkSuite.GetCheckRuns().GetNodes() {
+			check := &Check{
+				name:         checkRun.GetName(),
+				url:          checkRun.GetDetailsUrl(),
+				checkSuiteId: checkSuite.GetId(),
+				summary:      checkRun.GetSummary(),
+			}
+
+			if checkRun.GetAnnotations() != nil {
+				check.annotations = checkRun.GetAnnotations().GetNodes()
+			}
+
+			if checkSuite.GetApp() != nil {
+				check.name = fmt.Sprintf("%v / %v", checkSuite.GetApp().GetName(), checkRun.GetName())
+			}
+
+			if checkRun.GetTitle() != "" && check.summary == "" {
+				check.summary = checkRun.GetTitle()
+			}
+
+			switch {
+			case checkRun.GetStatus() != CheckStatusStateCompleted:
+				check.state, check.label = CHECK_PENDING, strings.ToLower(strings.ReplaceAll(string(checkRun.GetStatus()), "_", " "))
+			case checkRun.GetConclusion() == CheckConclusionStateSuccess:
+				check.state, check.label = CHECK_PASSING, "success"
+			case checkRun.GetConclusion() == CheckConclusionStateNeutral || checkRun.GetConclusion() == CheckConclusionStateSkipped:
+				check.state, check.label = CHECK_SKIPPED, strings.ToLower(string(checkRun.GetConclusion()))
+			default:
+				check.state, check.label = CHECK_FAILING, strings.ToLower(strings.ReplaceAll(string(checkRun.GetConclusion()), "_", " "))
+			}
+
+			checks = append(checks, check)
+		}
+	}
+
+	// Commits which did not receive any commit status do not have one.
+	var contexts []*StatusContextFields
+	if commit.GetStatus() != nil {
+		contexts = commit.GetStatus().GetContexts()
+	}
+
+	for _, context := range contexts {
+		check := &Check{
+			name:    context.GetContext(),
+			url:     context.GetTargetUrl(),
+			summary: context.GetDescription(),
+			label:   strings.ToLower(string(context.GetState())),
+		}
+
+		switch context.GetState() {
+		case StatusStateSuccess:
+			check.state = CHECK_PASSING
+		case StatusStatePending, StatusStateExpected:
+			check.state = CHECK_PENDING
+		default:
+			check.state = CHECK_FAILING
+		}
+
+		checks = append(checks, check)
+	}
+
+	sort.SliceStable(checks, func(i, j int) bool {
+		return checks[i].state < checks[j].state
+	})
+
+	return checks
+}
+
+type ChecksScreen struct {
+	*Window
+	*Settings
+	*Logger
+	*GithubApi
+	Viewport           viewport.Model
+	Spinner            spinner.Model
+	pullRequest        *PullRequest
+	repositoryId       string
+	checks             []*Check
+	checkOffsets       []int
+	SelectedCheckIndex int
+	// pending is set while a check suite is being re-requested.
+	pending bool
+	message string
+	err     error
+}
+
+func NewChecksScreen(globalState *Window, settings *Settings, logger *Logger, githubApi *GithubApi) *ChecksScreen {
+	return &ChecksScreen{
+		Window:    globalState,
+		Settings:  settings,
+		Logger:    logger,
+		GithubApi: githubApi,
+		Viewport:  viewport.New(0, 0),
+		Spinner:   spinner.New(spinner.WithSpinner(spinner.Dot), spinner.WithStyle(StyledSpinner)),
+	}
+}
+
+// checksFetchedMsg is emitted once checks of a pull request have been fetched.
+type checksFetchedMsg struct {
+	pullRequestId string
+	repositoryId  string
+	checks        []*Check
+	err           error
+}
+
+// checkSuiteRerequestedMsg is emitted once a check suite has been re-requested.
+type checkSuiteRerequestedMsg struct {
+	pullRequestId string
+	checkSuiteId  string
+	err           error
+}
+
+func (r *ChecksScreen) fetchChecks(pullRequest *PullRequest) tea.Cmd {
+	id := pullRequest.GetId()
+	owner, name := ParseRepositoryUrl(pullRequest.GetRepository().GetUrl())
+	number := pullRequest.GetNumber()
+
+	return func() tea.Msg {
+		r.Logger.Info(fmt.Sprintf("fetching checks of pull request %v/%v#%v", owner, name, number))
+
+		repository, err := r.GithubApi.GetPullRequestChecks(r.GithubApi.Context(), owner, name, number)
+		if err != nil {
+			return checksFetchedMsg{pullRequestId: id, err: err}
+		}
+
+		return checksFetchedMsg{pullRequestId: id, repositoryId: repository.GetId(), checks: mapChecks(repository)}
+	}
+}
+
+func (r *ChecksScreen) rerequestCheckSuite(checkSuiteId string) tea.Cmd {
+	pullRequestId := r.pullRequest.GetId()
+	repositoryId := r.repositoryId
+
+	return func() tea.Msg {
+		r.Logger.Info(fmt.Sprintf("re-requesting check suite %v", checkSuiteId))
+
+		err := r.GithubApi.RerequestCheckSuite(r.GithubApi.Context(), repositoryId, checkSuiteId)
+
+		return checkSuiteRerequestedMsg{pullRequestId: pullRequestId, checkSuiteId: checkSuiteId, err: err}
+	}
+}
+
+// Open shows checks of the pull request, fetching them in the background.
+func (r *ChecksScreen) Open(pullRequest *PullRequest) tea.Cmd {
+	r.pullRequest = pullRequest
+	r.repositoryId = ""
+	r.checks = nil
+	r.checkOffsets = nil
+	r.SelectedCheckIndex = 0
+	r.pending = false
+	r.message = ""
+	r.err = nil
+	r.resize()
+	r.Viewport.SetContent("")
+	r.Viewport.GotoTop()
+
+	return tea.Batch(r.Spinner.Tick, r.fetchChecks(pullRequest))
+}
+
+func (r *ChecksScreen) resize() {
+	help, _ := RenderHelp(CHECKS_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+
+	r.Viewport.Width = r.Window.Width - StyledMain.GetHorizontalPadding()
+	// One line is reserved for the status of pending actions.
+	r.Viewport.Height = r.Window.Height - StyledMain.GetVerticalPadding() - lipgloss.Height(StyledHeader.Render("")) - lipgloss.Height(help) - 1
+	if r.Viewport.Height < 1 {
+		r.Viewport.Height = 1
+	}
+}
+
+// render lays out checks in the viewport. Summary and annotations are displayed only for the selected check.
+func (r *ChecksScreen) render() {
+	if r.checks == nil {
+		return
+	}
+
+	if len(r.checks) == 0 {
+		r.Viewport.SetContent(StyledDraft.Render("The latest commit of this pull request does not have any checks."))
+		return
+	}
+
+	checkStateToUI := map[int]string{
+		CHECK_FAILING: StyledChangesRequested.Render("✗"),
+		CHECK_PENDING: StyledCommented.Render("●"),
+		CHECK_PASSING: StyledApproved.Render("✓"),
+		CHECK_SKIPPED: StyledDraft.Render("-"),
+	}
+
+	r.checkOffsets = nil
+	var lines []string
+	for i, check := range r.checks {
+		r.checkOffsets = append(r.checkOffsets, len(lines))
+
+		if i != r.SelectedCheckIndex {
+			lines = append(lines, fmt.Sprintf("  %v %v %v", checkStateToUI[check.state], check.name, StyledDraft.Render(check.label)))
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("%v%v %v %v", StyledSpinner.Render("▌ "), checkStateToUI[check.state], StyledUnderline.Render(check.name), StyledDraft.Render(check.label)))
+
+		summary := strings.TrimSpace(check.summary)
+		if summary == "" {
+			summary = "No summary provided."
+		}
+		for _, line := range strings.Split(wordwrap.String(summary, r.Viewport.Width-6), "\n") {
+			lines = append(lines, "      "+StyledHelpDescription.Render(line))
+		}
+
+		for _, annotation := range check.annotations {
+			level := StyledCommented.Render(strings.ToLower(string(annotation.GetAnnotationLevel())))
+			if annotation.GetAnnotationLevel() == CheckAnnotationLevelFailure {
+				level = StyledChangesRequested.Render("failure")
+			}
+
+			lines = append(lines, fmt.Sprintf("      %v %v:%v %v", level, annotation.GetPath(), annotation.GetLocation().GetStart().GetLine(), annotation.GetTitle()))
+			for _, line := range strings.Split(wordwrap.String(strings.TrimSpace(annotation.GetMessage()), r.Viewport.Width-8), "\n") {
+				lines = append(lines, "        "+line)
+			}
+		}
+	}
+
+	r.Viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+// selectCheck selects the check and scrolls the viewport so that its beginning is visible.
+func (r *ChecksScreen) selectCheck(index int) {
+	if len(r.checks) == 0 {
+		return
+	}
+
+	r.SelectedCheckIndex = (index + len(r.checks)) % len(r.checks)
+	r.render()
+
+	offset := r.checkOffsets[r.SelectedCheckIndex]
+	if offset < r.Viewport.YOffset || offset >= r.Viewport.YOffset+r.Viewport.Height {
+		r.Viewport.SetYOffset(offset)
+	}
+}
+
+func (r *ChecksScreen) selectedCheck() *Check {
+	if r.SelectedCheckIndex >= len(r.checks) {
+		return nil
+	}
+
+	return r.checks[r.SelectedCheckIndex]
+}
+
+func (r *ChecksScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (r *ChecksScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		{
+			if r.pullRequest != nil && (r.checks == nil && r.err == nil || r.pending) {
+				r.Spinner, cmd = r.Spinner.Update(msg)
+			}
+		}
+	case tea.WindowSizeMsg:
+		{
+			r.resize()
+			r.render()
+		}
+	case checksFetchedMsg:
+		{
+			if r.pullRequest == nil || msg.pullRequestId != r.pullRequest.GetId() {
+				break
+			}
+
+			if msg.err != nil {
+				r.Logger.Info(fmt.Sprintf("could not fetch checks of pull request %v", msg.pullRequestId))
+				r.Logger.Error(msg.err)
+
+				r.err = msg.err
+				break
+			}
+
+			r.repositoryId = msg.repositoryId
+			r.checks = msg.checks
+			if r.SelectedCheckIndex >= len(r.checks) {
+				r.SelectedCheckIndex = 0
+			}
+			r.render()
+		}
+	case checkSuiteRerequestedMsg:
+		{
+			if r.pullRequest == nil || msg.pullRequestId != r.pullRequest.GetId() {
+				break
+			}
+
+			r.pending = false
+			if msg.err != nil {
+				r.Logger.Info(fmt.Sprintf("could not re-request check suite %v", msg.checkSuiteId))
+				r.Logger.Error(msg.err)
+
+				r.err = msg.err
+				break
+			}
+
+			r.message = "Checks have been re-requested."
+			cmd = r.fetchChecks(r.pullRequest)
+		}
+	case tea.KeyMsg:
+		{
+			switch msg.String() {
+			case helpBack.Shortcut:
+				{
+					return r, openScreen(SCREEN_PULL_REQUESTS, nil)
+				}
+			case helpOpenCheck.Shortcut:
+				{
+					check := r.selectedCheck()
+					if check == nil || check.url == "" {
+						break
+					}
+
+					if err := openUrl(check.url); err != nil {
+						cmd = notifyError(fmt.Sprintf("could not open %v in browser", check.url), err)
+					}
+				}
+			case helpDown.Shortcut:
+				{
+					r.selectCheck(r.SelectedCheckIndex + 1)
+				}
+			case helpUp.Shortcut:
+				{
+					r.selectCheck(r.SelectedCheckIndex - 1)
+				}
+			case helpRerequestCheckSuite.Shortcut:
+				{
+					check := r.selectedCheck()
+					if check == nil || check.checkSuiteId == "" || check.state != CHECK_FAILING || r.pending {
+						break
+					}
+
+					r.pending = true
+					r.message = ""
+					r.err = nil
+					cmd = tea.Batch(r.Spinner.Tick, r.rerequestCheckSuite(check.checkSuiteId))
+				}
+			default:
+				{
+					r.Viewport, cmd = r.Viewport.Update(msg)
+				}
+			}
+		}
+	}
+
+	return r, cmd
+}
+
+func (r *ChecksScreen) View() string {
+	title := "Checks"
+	if r.pullRequest != nil {
+		title = fmt.Sprintf("Checks of pull request #%v", r.pullRequest.GetNumber())
+	}
+	header := StyledHeader.Render(title)
+
+	var content string
+	switch {
+	case r.checks == nil && r.err != nil:
+		content = StyledChangesRequested.Render(fmt.Sprintf("Could not fetch checks: %v", r.err))
+	case r.checks == nil:
+		content = fmt.Sprintf("%v Loading checks...", r.Spinner.View())
+	default:
+		content = r.Viewport.View()
+	}
+
+	status := ""
+	switch {
+	case r.pending:
+		status = fmt.Sprintf("%v Re-requesting checks...", r.Spinner.View())
+	case r.checks != nil && r.err != nil:
+		status = StyledChangesRequested.Render(fmt.Sprintf("Could not re-request checks: %v", r.err))
+	case r.message != "":
+		status = StyledApproved.Render(r.message)
+	}
+
+	help, err := RenderHelp(CHECKS_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+	if err != nil {
+		r.Logger.Error(err)
+	}
+
+	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, header, content, status, help))
+}
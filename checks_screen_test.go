@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMapChecks(t *testing.T) {
+	tests := []struct {
+		name string
+		// commits are JSON nodes of commits of the pull request, as returned by GitHub.
+		commits string
+		want    []string
+	}{
+		{
+			name:    "no commits",
+			commits: `[]`,
+		},
+		{
+			name:    "commit without checks and statuses",
+			commits: `[{"commit": {"checkSuites": {"nodes": []}, "status": null}}]`,
+		},
+		{
+			name: "check suites and status contexts are combined, failing first",
+			commits: `[{"commit": {
+				"checkSuites": {"nodes": [
+					{"id": "suite-1", "app": {"name": "GitHub Actions"}, "checkRuns": {"nodes": [
+						{"name": "build", "status": "COMPLETED", "conclusion": "SUCCESS"},
+						{"name": "lint", "status": "COMPLETED", "conclusion": "FAILURE", "title": "2 errors"},
+						{"name": "test", "status": "IN_PROGRESS"}
+					]}},
+					{"id": "suite-2", "app": null, "checkRuns": {"nodes": [
+						{"name": "deploy", "status": "COMPLETED", "conclusion": "SKIPPED", "summary": "not a release"}
+					]}}
+				]},
+				"status": {"contexts": [
+					{"context": "ci/jenkins", "state": "SUCCESS", "description": "Build passed"},
+					{"context": "coverage", "state": "PENDING"},
+					{"context": "legacy", "state": "ERROR"}
+				]}
+			}}]`,
+			want: []string{
+				`1 "failure" "GitHub Actions / lint" "2 errors" suite-1`,
+				`1 "error" "legacy" "" `,
+				`2 "in progress" "GitHub Actions / test" "" suite-1`,
+				`2 "pending" "coverage" "" `,
+				`3 "success" "GitHub Actions / build" "" suite-1`,
+				`3 "success" "ci/jenkins" "Build passed" `,
+				`4 "skipped" "deploy" "not a release" suite-2`,
+			},
+		},
+		{
+			name: "only the latest commit is checked",
+			commits: `[
+				{"commit": {"checkSuites": {"nodes": [{"id": "old", "checkRuns": {"nodes": [
+					{"name": "build", "status": "COMPLETED", "conclusion": "FAILURE"}
+				]}}]}}},
+				{"commit": {"checkSuites": {"nodes": [{"id": "new", "checkRuns": {"nodes": [
+					{"name": "build", "status": "COMPLETED", "conclusion": "SUCCESS"}
+				]}}]}}}
+			]`,
+			want: []string{
+				`3 "success" "build" "" new`,
+			},
+		},
+		{
+			name: "conclusions are described in words",
+			commits: `[{"commit": {"checkSuites": {"nodes": [{"id": "suite", "checkRuns": {"nodes": [
+				{"name": "e2e", "status": "COMPLETED", "conclusion": "TIMED_OUT", "title": "Timed out", "summary": "Exceeded 60 minutes"},
+				{"name": "docs", "status": "COMPLETED", "conclusion": "NEUTRAL"},
+				{"name": "release", "status": "QUEUED"}
+			]}}]}}}]`,
+			want: []string{
+				`1 "timed out" "e2e" "Exceeded 60 minutes" suite`,
+				`2 "queued" "release" "" suite`,
+				`4 "neutral" "docs" "" suite`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repository := &getPullRequestChecksRepository{}
+			err := json.Unmarshal([]byte(fmt.Sprintf(`{"pullRequest": {"commits": {"nodes": %v}}}`, test.commits)), repository)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, check := range mapChecks(repository) {
+				got = append(got, fmt.Sprintf("%v %q %q %q %v", check.state, check.label, check.name, check.summary, check.checkSuiteId))
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("mapChecks() =\n%v\nwant\n%v", strings.Join(got, "\n"), strings.Join(test.want, "\n"))
+			}
+		})
+	}
+}
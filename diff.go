@@ -0,0 +1,372 @@
+package main
+
+import (
+	"fmt"
+	"github.com/alecthomas/chroma"
+	"github.com/alecthomas/chroma/formatters"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/alecthomas/chroma/styles"
+	"github.com/muesli/reflow/ansi"
+	"github.com/muesli/reflow/truncate"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	DIFF_LINE_CONTEXT = 1
+	DIFF_LINE_ADDED   = 2
+	DIFF_LINE_DELETED = 3
+)
+
+const (
+	DIFF_SIDE_BOTH  = 1
+	DIFF_SIDE_LEFT  = 2
+	DIFF_SIDE_RIGHT = 3
+)
+
+const DIFF_TAB_WIDTH = 4
+
+var hunkHeaderRegexp = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// DiffLine is a single line of a hunk. Line numbers are 0 when the line does not exist on the given side.
+type DiffLine struct {
+	kind        int
+	content     string
+	highlighted string
+	oldNumber   int
+	newNumber   int
+}
+
+type DiffHunk struct {
+	header string
+	lines  []*DiffLine
+}
+
+// DiffFile is a changed file with its patch split into hunks.
+type DiffFile struct {
+	*PullRequestFile
+	hunks []*DiffHunk
+}
+
+// NewDiffFile parses the patch of a file and highlights its lines based on the file extension.
+func NewDiffFile(file *PullRequestFile) *DiffFile {
+	diffFile := &DiffFile{
+		PullRequestFile: file,
+		hunks:           ParsePatch(file.Patch),
+	}
+
+	lexer := lexers.Match(file.Filename)
+	for _, hunk := range diffFile.hunks {
+		for _, line := range hunk.lines {
+			line.highlighted = highlightCode(lexer, line.content)
+		}
+	}
+
+	return diffFile
+}
+
+// ParsePatch splits a unified diff patch, as returned by GitHub for a single file, into hunks.
+func ParsePatch(patch string) []*DiffHunk {
+	var hunks []*DiffHunk
+	var hunk *DiffHunk
+	oldNumber, newNumber := 0, 0
+
+	for _, line := range strings.Split(patch, "\n") {
+		if matches := hunkHeaderRegexp.FindStringSubmatch(line); matches != nil {
+			oldNumber, _ = strconv.Atoi(matches[1])
+			newNumber, _ = strconv.Atoi(matches[2])
+			hunk = &DiffHunk{header: line}
+			hunks = append(hunks, hunk)
+			continue
+		}
+
+		if hunk == nil || line == "" {
+			continue
+		}
+
+		content := strings.ReplaceAll(line[1:], "\t", strings.Repeat(" ", DIFF_TAB_WIDTH))
+		switch line[0] {
+		case '+':
+			{
+				hunk.lines = append(hunk.lines, &DiffLine{kind: DIFF_LINE_ADDED, content: content, newNumber: newNumber})
+				newNumber++
+			}
+		case '-':
+			{
+				hunk.lines = append(hunk.lines, &DiffLine{kind: DIFF_LINE_DELETED, content: content, oldNumber: oldNumber})
+				oldNumber++
+			}
+		case ' ':
+			{
+				hunk.lines = append(hunk.lines, &DiffLine{kind: DIFF_LINE_CONTEXT, content: content, oldNumber: oldNumber, newNumber: newNumber})
+				oldNumber++
+				newNumber++
+			}
+		}
+	}
+
+	return hunks
+}
+
+// highlightCode colors a single line of code. Lines are highlighted one by one, because hunks only contain fragments
+// of a file, so constructs spanning multiple lines (e.g. block comments) may not be recognized.
+func highlightCode(lexer chroma.Lexer, code string) string {
+	if lexer == nil {
+		return code
+	}
+
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, code)
+	if err != nil {
+		return code
+	}
+
+	var builder strings.Builder
+	err = formatters.TTY256.Format(&builder, styles.Get("monokai"), iterator)
+	if err != nil {
+		return code
+	}
+
+	return strings.TrimRight(builder.String(), "\n")
+}
+
+// fitWidth truncates or pads a string containing ANSI sequences so that it takes exactly the given width.
+func fitWidth(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	text = truncate.String(text, uint(width))
+	if padding := width - ansi.PrintableRuneWidth(text); padding > 0 {
+		text += strings.Repeat(" ", padding)
+	}
+
+	return text
+}
+
+func lineNumber(number int) string {
+	if number == 0 {
+		return "    "
+	}
+
+	return fmt.Sprintf("%4d", number)
+}
+
+// renderDiffLine renders a line with its line numbers and marker. Unified layout shows both line numbers, split
+// layout shows only the one of the side the line is displayed on.
+func renderDiffLine(line *DiffLine, width int, side int) string {
+	if line == nil {
+		return fitWidth("", width)
+	}
+
+	marker := " "
+	style := StyledDraft
+	switch line.kind {
+	case DIFF_LINE_ADDED:
+		{
+			marker = "+"
+			style = StyledApproved
+		}
+	case DIFF_LINE_DELETED:
+		{
+			marker = "-"
+			style = StyledChangesRequested
+		}
+	}
+
+	var gutter string
+	switch side {
+	case DIFF_SIDE_LEFT:
+		gutter = fmt.Sprintf("%v %v ", lineNumber(line.oldNumber), marker)
+	case DIFF_SIDE_RIGHT:
+		gutter = fmt.Sprintf("%v %v ", lineNumber(line.newNumber), marker)
+	default:
+		gutter = fmt.Sprintf("%v %v %v ", lineNumber(line.oldNumber), lineNumber(line.newNumber), marker)
+	}
+
+	return fitWidth(style.Render(gutter)+line.highlighted, width)
+}
+
+// renderFileHeader renders a line describing how a file has changed.
+func renderFileHeader(file *DiffFile, width int) string {
+	name := file.Filename
+	if file.PreviousFilename != "" {
+		name = fmt.Sprintf("%v → %v", file.PreviousFilename, file.Filename)
+	}
+
+	header := fmt.Sprintf("%v %v %v %v", StyledUnderline.Render(name), StyledDraft.Render(file.Status), StyledApproved.Render(fmt.Sprintf("+%v", file.Additions)), StyledChangesRequested.Render(fmt.Sprintf("-%v", file.Deletions)))
+
+	return fitWidth(header, width)
+}
+
+// splitHunkRows pairs lines of a hunk for split layout. Deleted lines are displayed next to added lines that replace
+// them, context lines are displayed on both sides.
+func splitHunkRows(hunk *DiffHunk) [][2]*DiffLine {
+	var rows [][2]*DiffLine
+	var deleted, added []*DiffLine
+
+	flush := func() {
+		for i := 0; i < len(deleted) || i < len(added); i++ {
+			var row [2]*DiffLine
+			if i < len(deleted) {
+				row[0] = deleted[i]
+			}
+			if i < len(added) {
+				row[1] = added[i]
+			}
+			rows = append(rows, row)
+		}
+		deleted, added = nil, nil
+	}
+
+	for _, line := range hunk.lines {
+		switch line.kind {
+		case DIFF_LINE_DELETED:
+			{
+				if len(added) > 0 {
+					flush()
+				}
+				deleted = append(deleted, line)
+			}
+		case DIFF_LINE_ADDED:
+			{
+				added = append(added, line)
+			}
+		default:
+			{
+				flush()
+				rows = append(rows, [2]*DiffLine{line, line})
+			}
+		}
+	}
+	flush()
+
+	return rows
+}
+
+// DiffTarget identifies a line of a file that can be commented.
+type DiffTarget struct {
+	path string
+	line int
+	side DiffSide
+}
+
+func (r *DiffTarget) key() string {
+	return fmt.Sprintf("%v:%v:%v", r.path, r.line, r.side)
+}
+
+// newDiffTarget returns the target of a line. Comments on deleted lines refer to the old version of the file, all
+// other comments refer to the new one.
+func newDiffTarget(file *DiffFile, line *DiffLine) *DiffTarget {
+	if line.kind == DIFF_LINE_DELETED {
+		return &DiffTarget{path: file.Filename, line: line.oldNumber, side: DiffSideLeft}
+	}
+
+	return &DiffTarget{path: file.Filename, line: line.newNumber, side: DiffSideRight}
+}
+
+// RenderedDiff holds rendered lines together with what they refer to.
+type RenderedDiff struct {
+	lines []string
+	// targets holds, for every rendered line, the line of a file it displays, or nil if it can not be commented.
+	targets     []*DiffTarget
+	fileOffsets []int
+	hunkOffsets []int
+}
+
+func (r *RenderedDiff) GetFileOffsets() []int {
+	if r == nil {
+		return nil
+	}
+
+	return r.fileOffsets
+}
+
+func (r *RenderedDiff) GetHunkOffsets() []int {
+	if r == nil {
+		return nil
+	}
+
+	return r.hunkOffsets
+}
+
+func (r *RenderedDiff) append(line string, target *DiffTarget) {
+	r.lines = append(r.lines, line)
+	r.targets = append(r.targets, target)
+}
+
+// appendComments renders draft comments placed on any of the targets below the line they refer to.
+func (r *RenderedDiff) appendComments(comments map[string][]*DraftComment, width int, targets ...*DiffTarget) {
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+
+		for _, comment := range comments[target.key()] {
+			for _, line := range strings.Split(comment.Body, "\n") {
+				r.append(fitWidth(StyledCommented.Render("       ✎ "+line), width), nil)
+			}
+		}
+	}
+}
+
+// RenderDiff renders files in unified or split layout, with draft comments displayed below lines they were placed on.
+// Besides rendered lines, it keeps track of lines at which files and hunks start, so that they can be navigated to.
+func RenderDiff(files []*DiffFile, width int, split bool, comments []*DraftComment) *RenderedDiff {
+	rendered := &RenderedDiff{}
+
+	commentsByTarget := map[string][]*DraftComment{}
+	for _, comment := range comments {
+		target := &DiffTarget{path: comment.Path, line: comment.Line, side: comment.Side}
+		commentsByTarget[target.key()] = append(commentsByTarget[target.key()], comment)
+	}
+
+	for _, file := range files {
+		rendered.fileOffsets = append(rendered.fileOffsets, len(rendered.lines))
+		rendered.append(renderFileHeader(file, width), nil)
+
+		if len(file.hunks) == 0 {
+			rendered.append(StyledDraft.Render("  Binary file or diff too large to display"), nil)
+			rendered.append("", nil)
+			continue
+		}
+
+		for _, hunk := range file.hunks {
+			rendered.hunkOffsets = append(rendered.hunkOffsets, len(rendered.lines))
+			rendered.append(fitWidth(StyledAwaiting.Render(hunk.header), width), nil)
+
+			if !split {
+				for _, line := range hunk.lines {
+					target := newDiffTarget(file, line)
+					rendered.append(renderDiffLine(line, width, DIFF_SIDE_BOTH), target)
+					rendered.appendComments(commentsByTarget, width, target)
+				}
+				continue
+			}
+
+			columnWidth := (width - 1) / 2
+			for _, row := range splitHunkRows(hunk) {
+				var leftTarget, rightTarget *DiffTarget
+				if row[0] != nil && row[0].kind == DIFF_LINE_DELETED {
+					leftTarget = newDiffTarget(file, row[0])
+				}
+				if row[1] != nil {
+					rightTarget = newDiffTarget(file, row[1])
+				}
+
+				// Lines which exist in the new version of the file are the ones that are commented most often.
+				target := rightTarget
+				if target == nil {
+					target = leftTarget
+				}
+
+				rendered.append(renderDiffLine(row[0], columnWidth, DIFF_SIDE_LEFT)+StyledDraft.Render("│")+renderDiffLine(row[1], columnWidth, DIFF_SIDE_RIGHT), target)
+				rendered.appendComments(commentsByTarget, width, leftTarget, rightTarget)
+			}
+		}
+
+		rendered.append("", nil)
+	}
+
+	return rendered
+}
@@ -0,0 +1,445 @@
+package main
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"strings"
+)
+
+var DIFF_HELP = []Help{helpUp, helpDown, helpNextFile, helpPreviousFile, helpNextHunk, helpPreviousHunk, helpToggleDiffLayout, helpCommentLine, helpDeleteDraftComments, helpBack, helpOpenPullRequest}
+
+var COMMENT_HELP = []Help{helpSaveDraftComment, helpEscape}
+
+const COMPOSE_COMMENT string = "COMPOSE_COMMENT"
+
+type DiffScreen struct {
+	*Window
+	*Settings
+	*Logger
+	*GithubApi
+	*Drafts
+	Viewport      viewport.Model
+	Spinner       spinner.Model
+	Composer      textarea.Model
+	state         string
+	pullRequest   *PullRequest
+	files         []*DiffFile
+	rendered      *RenderedDiff
+	cursor        int
+	commentTarget *DiffTarget
+	split         bool
+	err           error
+}
+
+func NewDiffScreen(globalState *Window, settings *Settings, logger *Logger, githubApi *GithubApi, drafts *Drafts) *DiffScreen {
+	composer := textarea.New()
+	composer.Placeholder = "Leave a comment..."
+	composer.ShowLineNumbers = false
+	composer.CharLimit = 0
+
+	return &DiffScreen{
+		Window:    globalState,
+		Settings:  settings,
+		Logger:    logger,
+		GithubApi: githubApi,
+		Drafts:    drafts,
+		Viewport:  viewport.New(0, 0),
+		Spinner:   spinner.New(spinner.WithSpinner(spinner.Dot), spinner.WithStyle(StyledSpinner)),
+		Composer:  composer,
+		state:     DEFAULT,
+	}
+}
+
+// pullRequestFilesFetchedMsg is emitted once changed files of a pull request have been fetched.
+type pullRequestFilesFetchedMsg struct {
+	id    string
+	files []*DiffFile
+	err   error
+}
+
+func (r *DiffScreen) fetchFiles(pullRequest *PullRequest) tea.Cmd {
+	id := pullRequest.GetId()
+	owner, name := ParseRepositoryUrl(pullRequest.GetRepository().GetUrl())
+	number := pullRequest.GetNumber()
+	maxPages := r.Settings.GetMaxPages()
+
+	return func() tea.Msg {
+		r.Logger.Info(fmt.Sprintf("fetching files of pull request %v/%v#%v", owner, name, number))
+
+		files, err := r.GithubApi.GetPullRequestFiles(r.GithubApi.Context(), owner, name, number, maxPages)
+		if err != nil {
+			return pullRequestFilesFetchedMsg{id: id, err: err}
+		}
+
+		var diffFiles []*DiffFile
+		for _, file := range files {
+			diffFiles = append(diffFiles, NewDiffFile(file))
+		}
+
+		return pullRequestFilesFetchedMsg{id: id, files: diffFiles}
+	}
+}
+
+// Open shows changes of the pull request, fetching them in the background.
+func (r *DiffScreen) Open(pullRequest *PullRequest) tea.Cmd {
+	r.pullRequest = pullRequest
+	r.files = nil
+	r.rendered = nil
+	r.cursor = 0
+	r.err = nil
+	r.closeComposer()
+	r.resize()
+	r.Viewport.SetContent("")
+	r.Viewport.GotoTop()
+
+	return tea.Batch(r.Spinner.Tick, r.fetchFiles(pullRequest))
+}
+
+func (r *DiffScreen) resize() {
+	help, _ := RenderHelp(DIFF_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+
+	r.Viewport.Width = r.Window.Width - StyledMain.GetHorizontalPadding()
+	r.Viewport.Height = r.Window.Height - StyledMain.GetVerticalPadding() - lipgloss.Height(StyledHeader.Render("")) - lipgloss.Height(help)
+	if r.Viewport.Height < 1 {
+		r.Viewport.Height = 1
+	}
+
+	r.Composer.SetWidth(r.Viewport.Width)
+}
+
+// render lays out the diff again, e.g. after the layout or draft comments have changed.
+func (r *DiffScreen) render() {
+	if r.files == nil {
+		return
+	}
+
+	// Two columns are reserved for the cursor.
+	r.rendered = RenderDiff(r.files, r.Viewport.Width-2, r.split, r.Drafts.GetComments(r.pullRequest.GetId()))
+	if r.cursor >= len(r.rendered.lines) {
+		r.cursor = len(r.rendered.lines) - 1
+	}
+	if r.cursor < 0 {
+		r.cursor = 0
+	}
+
+	r.refresh()
+}
+
+// refresh updates content of the viewport with the cursor placed on the selected line.
+func (r *DiffScreen) refresh() {
+	if r.rendered == nil {
+		return
+	}
+
+	if len(r.files) == 0 {
+		r.Viewport.SetContent(StyledDraft.Render("This pull request does not change any files."))
+		return
+	}
+
+	lines := make([]string, len(r.rendered.lines))
+	for i, line := range r.rendered.lines {
+		if i == r.cursor {
+			lines[i] = StyledSpinner.Render("▌ ") + line
+		} else {
+			lines[i] = "  " + line
+		}
+	}
+
+	r.Viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+// moveCursor selects the given line and scrolls the viewport so that it is visible.
+func (r *DiffScreen) moveCursor(cursor int) {
+	if r.rendered == nil || len(r.rendered.lines) == 0 {
+		return
+	}
+
+	r.cursor = cursor
+	if r.cursor < 0 {
+		r.cursor = 0
+	}
+	if r.cursor >= len(r.rendered.lines) {
+		r.cursor = len(r.rendered.lines) - 1
+	}
+
+	if r.cursor < r.Viewport.YOffset {
+		r.Viewport.SetYOffset(r.cursor)
+	}
+	if r.cursor >= r.Viewport.YOffset+r.Viewport.Height {
+		r.Viewport.SetYOffset(r.cursor - r.Viewport.Height + 1)
+	}
+
+	r.refresh()
+}
+
+// moveCursorToNext selects the first offset below the cursor and scrolls it to the top of the viewport.
+func (r *DiffScreen) moveCursorToNext(offsets []int) {
+	for _, offset := range offsets {
+		if offset > r.cursor {
+			r.Viewport.SetYOffset(offset)
+			r.moveCursor(offset)
+			return
+		}
+	}
+}
+
+// moveCursorToPrevious selects the last offset above the cursor and scrolls it to the top of the viewport.
+func (r *DiffScreen) moveCursorToPrevious(offsets []int) {
+	for i := len(offsets) - 1; i >= 0; i-- {
+		if offsets[i] < r.cursor {
+			r.Viewport.SetYOffset(offsets[i])
+			r.moveCursor(offsets[i])
+			return
+		}
+	}
+}
+
+func (r *DiffScreen) selectedTarget() *DiffTarget {
+	if r.rendered == nil || r.cursor >= len(r.rendered.targets) {
+		return nil
+	}
+
+	return r.rendered.targets[r.cursor]
+}
+
+func (r *DiffScreen) openComposer(target *DiffTarget) tea.Cmd {
+	r.state = COMPOSE_COMMENT
+	r.commentTarget = target
+	r.Composer.Reset()
+	r.Composer.SetHeight(10)
+
+	return r.Composer.Focus()
+}
+
+func (r *DiffScreen) closeComposer() {
+	r.state = DEFAULT
+	r.commentTarget = nil
+	r.Composer.Reset()
+	r.Composer.Blur()
+}
+
+func (r *DiffScreen) updateComposer(msg tea.KeyMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case helpEscape.Shortcut:
+		{
+			r.closeComposer()
+		}
+	case helpSaveDraftComment.Shortcut:
+		{
+			body := strings.TrimSpace(r.Composer.Value())
+			if body != "" {
+				err := r.Drafts.AddComment(r.pullRequest.GetId(), &DraftComment{
+					Path: r.commentTarget.path,
+					Line: r.commentTarget.line,
+					Side: r.commentTarget.side,
+					Body: body,
+				})
+				if err != nil {
+					cmd = notifyError("could not save drafts", err)
+				}
+			}
+
+			r.closeComposer()
+			r.render()
+		}
+	default:
+		{
+			r.Composer, cmd = r.Composer.Update(msg)
+		}
+	}
+
+	return cmd
+}
+
+func (r *DiffScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (r *DiffScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		{
+			if r.pullRequest != nil && r.files == nil && r.err == nil {
+				r.Spinner, cmd = r.Spinner.Update(msg)
+			}
+		}
+	case tea.WindowSizeMsg:
+		{
+			r.resize()
+			r.render()
+		}
+	case reviewSubmittedMsg:
+		{
+			// Submitted draft comments are removed from the store, so they should disappear from the diff too.
+			if r.pullRequest != nil && msg.pullRequestId == r.pullRequest.GetId() && msg.err == nil {
+				r.render()
+			}
+		}
+	case pullRequestFilesFetchedMsg:
+		{
+			if r.pullRequest == nil || msg.id != r.pullRequest.GetId() {
+				break
+			}
+
+			if msg.err != nil {
+				r.Logger.Info(fmt.Sprintf("could not fetch files of pull request %v", msg.id))
+				r.Logger.Error(msg.err)
+
+				r.err = msg.err
+				break
+			}
+
+			r.files = msg.files
+			if r.files == nil {
+				r.files = []*DiffFile{}
+			}
+			r.render()
+		}
+	case tea.KeyMsg:
+		{
+			if r.state == COMPOSE_COMMENT {
+				cmd = r.updateComposer(msg)
+				break
+			}
+
+			switch msg.String() {
+			case helpBack.Shortcut:
+				{
+					return r, openScreen(SCREEN_PULL_REQUESTS, nil)
+				}
+			case helpOpenPullRequest.Shortcut:
+				{
+					if r.pullRequest == nil {
+						break
+					}
+
+					if err := openUrl(r.pullRequest.GetUrl() + "/files"); err != nil {
+						cmd = notifyError("could not open pull request in browser", err)
+					}
+				}
+			case helpDown.Shortcut:
+				{
+					r.moveCursor(r.cursor + 1)
+				}
+			case helpUp.Shortcut:
+				{
+					r.moveCursor(r.cursor - 1)
+				}
+			case helpNextFile.Shortcut:
+				{
+					r.moveCursorToNext(r.rendered.GetFileOffsets())
+				}
+			case helpPreviousFile.Shortcut:
+				{
+					r.moveCursorToPrevious(r.rendered.GetFileOffsets())
+				}
+			case helpNextHunk.Shortcut:
+				{
+					r.moveCursorToNext(r.rendered.GetHunkOffsets())
+				}
+			case helpPreviousHunk.Shortcut:
+				{
+					r.moveCursorToPrevious(r.rendered.GetHunkOffsets())
+				}
+			case helpToggleDiffLayout.Shortcut:
+				{
+					r.split = !r.split
+					r.cursor = 0
+					r.Viewport.GotoTop()
+					r.render()
+				}
+			case helpCommentLine.Shortcut:
+				{
+					target := r.selectedTarget()
+					if target == nil {
+						break
+					}
+
+					cmd = r.openComposer(target)
+				}
+			case helpDeleteDraftComments.Shortcut:
+				{
+					target := r.selectedTarget()
+					if target == nil {
+						break
+					}
+
+					if err := r.Drafts.DeleteLineComments(r.pullRequest.GetId(), target.path, target.line, target.side); err != nil {
+						cmd = notifyError("could not save drafts", err)
+					}
+					r.render()
+				}
+			default:
+				{
+					r.Viewport, cmd = r.Viewport.Update(msg)
+
+					// Cursor stays on screen when the viewport is scrolled by a page.
+					if r.cursor < r.Viewport.YOffset || r.cursor >= r.Viewport.YOffset+r.Viewport.Height {
+						r.moveCursor(r.Viewport.YOffset)
+					}
+				}
+			}
+		}
+	}
+
+	// Composer needs messages other than key presses too, e.g. to blink its cursor.
+	if _, ok := msg.(tea.KeyMsg); !ok && r.state == COMPOSE_COMMENT {
+		var composerCmd tea.Cmd
+		r.Composer, composerCmd = r.Composer.Update(msg)
+		cmd = tea.Batch(cmd, composerCmd)
+	}
+
+	return r, cmd
+}
+
+func (r *DiffScreen) View() string {
+	if r.state == COMPOSE_COMMENT {
+		header := StyledHeader.Render(fmt.Sprintf("Comment on %v line %v", r.commentTarget.path, r.commentTarget.line))
+
+		help, err := RenderHelp(COMMENT_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+		if err != nil {
+			r.Logger.Error(err)
+		}
+
+		return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, header, r.Composer.View(), "", help))
+	}
+
+	title := "Changes"
+	if r.pullRequest != nil {
+		layout := "unified"
+		if r.split {
+			layout = "split"
+		}
+		title = fmt.Sprintf("Changes of pull request #%v · %v", r.pullRequest.GetNumber(), layout)
+
+		if count := len(r.Drafts.GetComments(r.pullRequest.GetId())); count > 0 {
+			title += fmt.Sprintf(" · %v pending comments", count)
+		}
+	}
+	header := StyledHeader.Render(title)
+
+	var content string
+	switch {
+	case r.err != nil:
+		content = StyledChangesRequested.Render(fmt.Sprintf("Could not fetch changes: %v", r.err))
+	case r.files == nil:
+		content = fmt.Sprintf("%v Loading changes...", r.Spinner.View())
+	default:
+		content = r.Viewport.View()
+	}
+
+	help, err := RenderHelp(DIFF_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+	if err != nil {
+		r.Logger.Error(err)
+	}
+
+	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, header, content, help))
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// describeHunks describes hunks with one line per diff line, so that differences are easy to spot in failures.
+func describeHunks(hunks []*DiffHunk) []string {
+	var description []string
+	for _, hunk := range hunks {
+		description = append(description, hunk.header)
+		for _, line := range hunk.lines {
+			description = append(description, describeDiffLine(line))
+		}
+	}
+
+	return description
+}
+
+func describeDiffLine(line *DiffLine) string {
+	if line == nil {
+		return "nil"
+	}
+
+	return fmt.Sprintf("%v %v %v %q", line.kind, line.oldNumber, line.newNumber, line.content)
+}
+
+func TestParsePatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+		want  []string
+	}{
+		{
+			name:  "file renamed without changes",
+			patch: "",
+		},
+		{
+			name: "single hunk",
+			patch: strings.Join([]string{
+				"@@ -10,3 +10,3 @@ func main() {",
+				" a",
+				"-b",
+				"+c",
+				" d",
+			}, "\n"),
+			want: []string{
+				"@@ -10,3 +10,3 @@ func main() {",
+				`1 10 10 "a"`,
+				`3 11 0 "b"`,
+				`2 0 11 "c"`,
+				`1 12 12 "d"`,
+			},
+		},
+		{
+			name: "hunk headers without line counts",
+			patch: strings.Join([]string{
+				"@@ -1 +1 @@",
+				"-a",
+				"+b",
+			}, "\n"),
+			want: []string{
+				"@@ -1 +1 @@",
+				`3 1 0 "a"`,
+				`2 0 1 "b"`,
+			},
+		},
+		{
+			name: "new file",
+			patch: strings.Join([]string{
+				"@@ -0,0 +1,2 @@",
+				"+a",
+				"+b",
+			}, "\n"),
+			want: []string{
+				"@@ -0,0 +1,2 @@",
+				`2 0 1 "a"`,
+				`2 0 2 "b"`,
+			},
+		},
+		{
+			name: "line numbers restart with every hunk",
+			patch: strings.Join([]string{
+				"@@ -1,2 +1,3 @@",
+				" a",
+				"+b",
+				" c",
+				"@@ -20,2 +21,1 @@",
+				" x",
+				"-y",
+			}, "\n"),
+			want: []string{
+				"@@ -1,2 +1,3 @@",
+				`1 1 1 "a"`,
+				`2 0 2 "b"`,
+				`1 2 3 "c"`,
+				"@@ -20,2 +21,1 @@",
+				`1 20 21 "x"`,
+				`3 21 0 "y"`,
+			},
+		},
+		{
+			name: "no newline at end of file",
+			patch: strings.Join([]string{
+				"@@ -1 +1 @@",
+				"-a",
+				`\ No newline at end of file`,
+				"+a",
+			}, "\n"),
+			want: []string{
+				"@@ -1 +1 @@",
+				`3 1 0 "a"`,
+				`2 0 1 "a"`,
+			},
+		},
+		{
+			name: "tabs are expanded",
+			patch: strings.Join([]string{
+				"@@ -1 +1 @@",
+				"+\tif a {",
+			}, "\n"),
+			want: []string{
+				"@@ -1 +1 @@",
+				`2 0 1 "    if a {"`,
+			},
+		},
+		{
+			name: "lines before the first hunk and trailing newline are ignored",
+			patch: strings.Join([]string{
+				"diff --git a/a.go b/a.go",
+				"@@ -1 +1 @@",
+				" a",
+				"",
+			}, "\n"),
+			want: []string{
+				"@@ -1 +1 @@",
+				`1 1 1 "a"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := describeHunks(ParsePatch(test.patch))
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ParsePatch() =\n%v\nwant\n%v", strings.Join(got, "\n"), strings.Join(test.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestSplitHunkRows(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+		want  []string
+	}{
+		{
+			name:  "context lines are displayed on both sides",
+			patch: "@@ -1,2 +1,2 @@\n a\n b",
+			want: []string{
+				`1 1 1 "a" | 1 1 1 "a"`,
+				`1 2 2 "b" | 1 2 2 "b"`,
+			},
+		},
+		{
+			name:  "deleted lines are paired with added lines",
+			patch: "@@ -1,3 +1,2 @@\n-a\n-b\n+c\n d",
+			want: []string{
+				`3 1 0 "a" | 2 0 1 "c"`,
+				`3 2 0 "b" | nil`,
+				`1 3 2 "d" | 1 3 2 "d"`,
+			},
+		},
+		{
+			name:  "more added than deleted lines",
+			patch: "@@ -1 +1,2 @@\n-a\n+b\n+c",
+			want: []string{
+				`3 1 0 "a" | 2 0 1 "b"`,
+				`nil | 2 0 2 "c"`,
+			},
+		},
+		{
+			name:  "deleted lines after added lines start a new row",
+			patch: "@@ -1,2 +1,1 @@\n+a\n-b\n-c",
+			want: []string{
+				`nil | 2 0 1 "a"`,
+				`3 1 0 "b" | nil`,
+				`3 2 0 "c" | nil`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got []string
+			for _, row := range splitHunkRows(ParsePatch(test.patch)[0]) {
+				got = append(got, fmt.Sprintf("%v | %v", describeDiffLine(row[0]), describeDiffLine(row[1])))
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("splitHunkRows() =\n%v\nwant\n%v", strings.Join(got, "\n"), strings.Join(test.want, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// DraftComment is a line comment that has not been submitted to GitHub yet.
+type DraftComment struct {
+	Path string   `json:"path"`
+	Line int      `json:"line"`
+	Side DiffSide `json:"side"`
+	Body string   `json:"body"`
+}
+
+// Drafts stores line comments of pending reviews, keyed by pull request id, so that they survive restarts.
+type Drafts struct {
+	Comments       map[string][]*DraftComment `json:"comments,omitempty"`
+	DraftsFilePath string
+	*Logger
+}
+
+func NewDrafts(logger *Logger) *Drafts {
+	home, _ := os.UserHomeDir()
+
+	return &Drafts{
+		Comments:       map[string][]*DraftComment{},
+		DraftsFilePath: home + "/" + ".tui-code-review-drafts.json",
+		Logger:         logger,
+	}
+}
+
+// Load reads drafts from the drafts file. Drafts are not essential, so when the file can not be read the app starts
+// without them and the error is returned to be shown to the user. A corrupt file is backed up next to the drafts file
+// before it gets overwritten by the next save.
+func (r *Drafts) Load() error {
+	_, err := os.Stat(r.DraftsFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return r.Save()
+		}
+
+		r.Logger.Info("could not stat drafts file")
+		r.Logger.Error(err)
+		return err
+	}
+
+	bytes, err := os.ReadFile(r.DraftsFilePath)
+	if err != nil {
+		r.Logger.Info("could not read drafts file")
+		r.Logger.Error(err)
+		return err
+	}
+
+	err = json.Unmarshal(bytes, r)
+	if err != nil {
+		r.Logger.Info("could not unmarshal drafts file")
+		r.Logger.Error(err)
+
+		r.Comments = map[string][]*DraftComment{}
+
+		backupFilePath := r.DraftsFilePath + ".bak"
+		if backupErr := os.WriteFile(backupFilePath, bytes, 0644); backupErr != nil {
+			r.Logger.Info("could not back up drafts file")
+			r.Logger.Error(backupErr)
+			return err
+		}
+
+		return fmt.Errorf("%w, the file was backed up to %v", err, backupFilePath)
+	}
+
+	if r.Comments == nil {
+		r.Comments = map[string][]*DraftComment{}
+	}
+
+	return nil
+}
+
+// Save writes drafts to the drafts file. Drafts stay in memory when they can not be written.
+func (r *Drafts) Save() error {
+	bytes, err := json.Marshal(r)
+	if err != nil {
+		r.Logger.Info("could not marshal drafts")
+		r.Logger.Error(err)
+		return err
+	}
+
+	err = os.WriteFile(r.DraftsFilePath, bytes, 0644)
+	if err != nil {
+		r.Logger.Info("could not write drafts file")
+		r.Logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *Drafts) GetComments(pullRequestId string) []*DraftComment {
+	return r.Comments[pullRequestId]
+}
+
+func (r *Drafts) AddComment(pullRequestId string, comment *DraftComment) error {
+	r.Comments[pullRequestId] = append(r.Comments[pullRequestId], comment)
+	return r.Save()
+}
+
+// DeleteLineComments removes comments placed on the given line of a file.
+func (r *Drafts) DeleteLineComments(pullRequestId string, path string, line int, side DiffSide) error {
+	var comments []*DraftComment
+	for _, comment := range r.Comments[pullRequestId] {
+		if comment.Path == path && comment.Line == line && comment.Side == side {
+			continue
+		}
+
+		comments = append(comments, comment)
+	}
+
+	if len(comments) == 0 {
+		delete(r.Comments, pullRequestId)
+	} else {
+		r.Comments[pullRequestId] = comments
+	}
+	return r.Save()
+}
+
+// ClearComments removes all comments of a pull request, e.g. once they have been submitted.
+func (r *Drafts) ClearComments(pullRequestId string) error {
+	delete(r.Comments, pullRequestId)
+	return r.Save()
+}
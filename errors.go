@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+)
+
+// Reasons of failed requests, shown to explain why e.g. a repository could not be fetched.
+const (
+	ERROR_REASON_NOT_FOUND    = "not found"
+	ERROR_REASON_FORBIDDEN    = "forbidden"
+	ERROR_REASON_UNAUTHORIZED = "unauthorized"
+	ERROR_REASON_RATE_LIMITED = "rate limited"
+	ERROR_REASON_TIMEOUT      = "timeout"
+	ERROR_REASON_NETWORK      = "network"
+	ERROR_REASON_SERVER       = "server error"
+	ERROR_REASON_UNKNOWN      = "error"
+)
+
+// ClassifyError finds out why a request to GitHub failed. GraphQL errors only carry a message, and responses with an
+// unexpected status are reported by genqlient as "returned error <status>: <body>", so apart from network errors the
+// reason is recognized by the message.
+func ClassifyError(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ERROR_REASON_TIMEOUT
+	}
+
+	if errors.Is(err, errRequestsPaused) {
+		return ERROR_REASON_RATE_LIMITED
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return ERROR_REASON_TIMEOUT
+		}
+
+		return ERROR_REASON_NETWORK
+	}
+
+	message := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(message, "returned error 401") || strings.Contains(message, "bad credentials"):
+		return ERROR_REASON_UNAUTHORIZED
+	case strings.Contains(message, "rate limit"):
+		return ERROR_REASON_RATE_LIMITED
+	case strings.Contains(message, "returned error 403") || strings.Contains(message, "resource not accessible") || strings.Contains(message, "saml"):
+		return ERROR_REASON_FORBIDDEN
+	case strings.Contains(message, "returned error 404") || strings.Contains(message, "could not resolve to"):
+		return ERROR_REASON_NOT_FOUND
+	case strings.Contains(message, "returned error 5"):
+		return ERROR_REASON_SERVER
+	}
+
+	return ERROR_REASON_UNKNOWN
+}
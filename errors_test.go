@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+)
+
+// timeoutError is a network error that timed out.
+type timeoutError struct{}
+
+func (r timeoutError) Error() string   { return "i/o timeout" }
+func (r timeoutError) Timeout() bool   { return true }
+func (r timeoutError) Temporary() bool { return true }
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "deadline exceeded",
+			err:  fmt.Errorf("fetching repositories: %w", context.DeadlineExceeded),
+			want: ERROR_REASON_TIMEOUT,
+		},
+		{
+			name: "requests paused by the rate limiter",
+			err:  fmt.Errorf("%w until 3:04PM", errRequestsPaused),
+			want: ERROR_REASON_RATE_LIMITED,
+		},
+		{
+			name: "network timeout",
+			err:  &net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{}},
+			want: ERROR_REASON_TIMEOUT,
+		},
+		{
+			name: "network error",
+			err:  &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+			want: ERROR_REASON_NETWORK,
+		},
+		{
+			name: "bad credentials",
+			err:  errors.New(`returned error 401 Unauthorized: {"message":"Bad credentials"}`),
+			want: ERROR_REASON_UNAUTHORIZED,
+		},
+		{
+			name: "rate limit exceeded",
+			err:  errors.New("returned error 403 Forbidden: API rate limit exceeded"),
+			want: ERROR_REASON_RATE_LIMITED,
+		},
+		{
+			name: "forbidden",
+			err:  errors.New("input:3: repository Resource not accessible by integration"),
+			want: ERROR_REASON_FORBIDDEN,
+		},
+		{
+			name: "saml enforcement",
+			err:  errors.New("Resource protected by organization SAML enforcement"),
+			want: ERROR_REASON_FORBIDDEN,
+		},
+		{
+			name: "repository not found",
+			err:  errors.New("input:3: Could not resolve to a Repository with the name 'acme/missing'."),
+			want: ERROR_REASON_NOT_FOUND,
+		},
+		{
+			name: "server error",
+			err:  errors.New("returned error 502 Bad Gateway: "),
+			want: ERROR_REASON_SERVER,
+		},
+		{
+			name: "unknown error",
+			err:  errors.New("something went wrong"),
+			want: ERROR_REASON_UNKNOWN,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ClassifyError(test.err); got != test.want {
+				t.Errorf("ClassifyError(%q) = %v, want %v", test.err, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"github.com/sahilm/fuzzy"
+	"strings"
+)
+
+const (
+	FILTER_STATE  = "state"
+	FILTER_REPO   = "repo"
+	FILTER_AUTHOR = "author"
+	FILTER_LABEL  = "label"
+)
+
+// PullRequestFilter narrows down the list of pull requests. Structured filters are written as key:value, values of the
+// same key are alternatives and different keys have to match all at once. Remaining words are fuzzy-matched against
+// title, author, repository and labels.
+type PullRequestFilter struct {
+	filters map[string][]string
+	terms   []string
+}
+
+// ParsePullRequestFilter parses a query such as `state:awaiting repo:api fix login`.
+func ParsePullRequestFilter(query string) *PullRequestFilter {
+	filter := &PullRequestFilter{filters: map[string][]string{}}
+
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		key, value, found := strings.Cut(word, ":")
+		if found && (key == FILTER_STATE || key == FILTER_REPO || key == FILTER_AUTHOR || key == FILTER_LABEL) {
+			// Filters without a value are being typed, so they do not hide anything yet.
+			if value != "" {
+				filter.filters[key] = append(filter.filters[key], value)
+			}
+			continue
+		}
+
+		filter.terms = append(filter.terms, word)
+	}
+
+	return filter
+}
+
+func (r *PullRequestFilter) IsEmpty() bool {
+	return len(r.filters) == 0 && len(r.terms) == 0
+}
+
+func getLabels(pullRequest *PullRequestFields) []string {
+	if pullRequest.GetLabels() == nil {
+		return nil
+	}
+
+	var labels []string
+	for _, label := range pullRequest.GetLabels().GetNodes() {
+		labels = append(labels, label.GetName())
+	}
+
+	return labels
+}
+
+// containsAny reports whether any of the values is a part of any of the texts, ignoring case.
+func containsAny(texts []string, values []string) bool {
+	for _, text := range texts {
+		for _, value := range values {
+			if strings.Contains(strings.ToLower(text), value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matches reports whether the pull request, displayed with the given state name, passes the filter.
+func (r *PullRequestFilter) matches(pullRequest *PullRequestFields, stateName string) bool {
+	for key, values := range r.filters {
+		var texts []string
+		switch key {
+		case FILTER_STATE:
+			texts = []string{stateName}
+		case FILTER_REPO:
+			texts = []string{pullRequest.GetRepository().GetNameWithOwner()}
+		case FILTER_AUTHOR:
+			texts = []string{pullRequest.GetAuthor().GetLogin()}
+		case FILTER_LABEL:
+			texts = getLabels(pullRequest)
+		}
+
+		if !containsAny(texts, values) {
+			return false
+		}
+	}
+
+	if len(r.terms) == 0 {
+		return true
+	}
+
+	target := strings.Join(append([]string{pullRequest.GetTitle(), pullRequest.GetAuthor().GetLogin(), pullRequest.GetRepository().GetNameWithOwner()}, getLabels(pullRequest)...), " ")
+	for _, term := range r.terms {
+		if len(fuzzy.Find(term, []string{strings.ToLower(target)})) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newFilterTestPullRequest() *PullRequestFields {
+	return &PullRequestFields{
+		Title:      "Fix login redirect",
+		Author:     &PullRequestFieldsAuthorUser{Login: "alice"},
+		Repository: &PullRequestFieldsRepository{NameWithOwner: "acme/api"},
+		Labels: &PullRequestFieldsLabelsLabelConnection{
+			Nodes: []*PullRequestFieldsLabelsLabelConnectionNodesLabel{{Name: "bug"}, {Name: "Needs QA"}},
+		},
+	}
+}
+
+func TestParsePullRequestFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		filters map[string][]string
+		terms   []string
+		isEmpty bool
+	}{
+		{
+			name:    "empty query",
+			query:   "  ",
+			filters: map[string][]string{},
+			isEmpty: true,
+		},
+		{
+			name:    "structured filters and terms",
+			query:   "state:awaiting repo:api fix login",
+			filters: map[string][]string{FILTER_STATE: {"awaiting"}, FILTER_REPO: {"api"}},
+			terms:   []string{"fix", "login"},
+		},
+		{
+			name:    "values of the same key are collected",
+			query:   "author:alice author:bob",
+			filters: map[string][]string{FILTER_AUTHOR: {"alice", "bob"}},
+		},
+		{
+			name:    "query is lowercased",
+			query:   "Label:BUG Login",
+			filters: map[string][]string{FILTER_LABEL: {"bug"}},
+			terms:   []string{"login"},
+		},
+		{
+			name:    "filter without a value is ignored",
+			query:   "state:",
+			filters: map[string][]string{},
+			isEmpty: true,
+		},
+		{
+			name:    "unknown key is a term",
+			query:   "draft:yes",
+			filters: map[string][]string{},
+			terms:   []string{"draft:yes"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter := ParsePullRequestFilter(test.query)
+
+			if !reflect.DeepEqual(filter.filters, test.filters) {
+				t.Errorf("filters = %v, want %v", filter.filters, test.filters)
+			}
+			if !reflect.DeepEqual(filter.terms, test.terms) {
+				t.Errorf("terms = %v, want %v", filter.terms, test.terms)
+			}
+			if filter.IsEmpty() != test.isEmpty {
+				t.Errorf("IsEmpty() = %v, want %v", filter.IsEmpty(), test.isEmpty)
+			}
+		})
+	}
+}
+
+func TestPullRequestFilterMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		matches bool
+	}{
+		{name: "empty query", query: "", matches: true},
+		{name: "state", query: "state:awaiting", matches: true},
+		{name: "other state", query: "state:approved", matches: false},
+		{name: "repository", query: "repo:api", matches: true},
+		{name: "author", query: "author:ali", matches: true},
+		{name: "label", query: "label:qa", matches: true},
+		{name: "any value of the same key", query: "author:bob author:alice", matches: true},
+		{name: "all keys", query: "author:alice repo:web", matches: false},
+		{name: "fuzzy term in title", query: "lgn rdr", matches: true},
+		{name: "term in label", query: "bug", matches: true},
+		{name: "term not found", query: "logout", matches: false},
+		{name: "filter and term", query: "state:awaiting redirect", matches: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matches := ParsePullRequestFilter(test.query).matches(newFilterTestPullRequest(), "awaiting")
+
+			if matches != test.matches {
+				t.Errorf("matches(%q) = %v, want %v", test.query, matches, test.matches)
+			}
+		})
+	}
+}
@@ -11,136 +11,327 @@ import (
 	"github.com/Khan/genqlient/graphql"
 )
 
-// The possible states of a pull request review.
-type PullRequestReviewState string
+// CheckAnnotationFields includes the GraphQL fields of CheckAnnotation requested by the fragment CheckAnnotationFields.
+// The GraphQL type's documentation follows.
+//
+// A single check annotation.
+type CheckAnnotationFields struct {
+	// The annotation's severity level.
+	AnnotationLevel CheckAnnotationLevel `json:"annotationLevel"`
+	// The path that this annotation was made on.
+	Path string `json:"path"`
+	// The annotation's title
+	Title string `json:"title"`
+	// The annotation's message.
+	Message string `json:"message"`
+	// The position of this annotation.
+	Location *CheckAnnotationFieldsLocationCheckAnnotationSpan `json:"location"`
+}
 
-const (
-	// A review allowing the pull request to merge.
-	PullRequestReviewStateApproved PullRequestReviewState = "APPROVED"
-	// A review blocking the pull request from merging.
-	PullRequestReviewStateChangesRequested PullRequestReviewState = "CHANGES_REQUESTED"
-	// An informational review.
-	PullRequestReviewStateCommented PullRequestReviewState = "COMMENTED"
-	// A review that has been dismissed.
-	PullRequestReviewStateDismissed PullRequestReviewState = "DISMISSED"
-	// A review that has not yet been submitted.
-	PullRequestReviewStatePending PullRequestReviewState = "PENDING"
-)
+// GetAnnotationLevel returns CheckAnnotationFields.AnnotationLevel, and is useful for accessing the field via an interface.
+func (v *CheckAnnotationFields) GetAnnotationLevel() CheckAnnotationLevel { return v.AnnotationLevel }
 
-// __getRepositoryInfoInput is used internally by genqlient
-type __getRepositoryInfoInput struct {
-	Owner string `json:"owner"`
-	Name  string `json:"name"`
+// GetPath returns CheckAnnotationFields.Path, and is useful for accessing the field via an interface.
+func (v *CheckAnnotationFields) GetPath() string { return v.Path }
+
+// GetTitle returns CheckAnnotationFields.Title, and is useful for accessing the field via an interface.
+func (v *CheckAnnotationFields) GetTitle() string { return v.Title }
+
+// GetMessage returns CheckAnnotationFields.Message, and is useful for accessing the field via an interface.
+func (v *CheckAnnotationFields) GetMessage() string { return v.Message }
+
+// GetLocation returns CheckAnnotationFields.Location, and is useful for accessing the field via an interface.
+func (v *CheckAnnotationFields) GetLocation() *CheckAnnotationFieldsLocationCheckAnnotationSpan {
+	return v.Location
 }
 
-// GetOwner returns __getRepositoryInfoInput.Owner, and is useful for accessing the field via an interface.
-func (v *__getRepositoryInfoInput) GetOwner() string { return v.Owner }
+// CheckAnnotationFieldsLocationCheckAnnotationSpan includes the requested fields of the GraphQL type CheckAnnotationSpan.
+// The GraphQL type's documentation follows.
+//
+// An inclusive pair of positions for a check annotation.
+type CheckAnnotationFieldsLocationCheckAnnotationSpan struct {
+	// Start position (inclusive).
+	Start *CheckAnnotationFieldsLocationCheckAnnotationSpanStartCheckAnnotationPosition `json:"start"`
+}
 
-// GetName returns __getRepositoryInfoInput.Name, and is useful for accessing the field via an interface.
-func (v *__getRepositoryInfoInput) GetName() string { return v.Name }
+// GetStart returns CheckAnnotationFieldsLocationCheckAnnotationSpan.Start, and is useful for accessing the field via an interface.
+func (v *CheckAnnotationFieldsLocationCheckAnnotationSpan) GetStart() *CheckAnnotationFieldsLocationCheckAnnotationSpanStartCheckAnnotationPosition {
+	return v.Start
+}
 
-// getRepositoryInfoRepository includes the requested fields of the GraphQL type Repository.
+// CheckAnnotationFieldsLocationCheckAnnotationSpanStartCheckAnnotationPosition includes the requested fields of the GraphQL type CheckAnnotationPosition.
 // The GraphQL type's documentation follows.
 //
-// A repository contains the content for a project.
-type getRepositoryInfoRepository struct {
-	// A list of pull requests that have been opened in the repository.
-	PullRequests *getRepositoryInfoRepositoryPullRequestsPullRequestConnection `json:"pullRequests"`
+// A character position in a check annotation.
+type CheckAnnotationFieldsLocationCheckAnnotationSpanStartCheckAnnotationPosition struct {
+	// Line number (1 indexed).
+	Line int `json:"line"`
 }
 
-// GetPullRequests returns getRepositoryInfoRepository.PullRequests, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepository) GetPullRequests() *getRepositoryInfoRepositoryPullRequestsPullRequestConnection {
-	return v.PullRequests
+// GetLine returns CheckAnnotationFieldsLocationCheckAnnotationSpanStartCheckAnnotationPosition.Line, and is useful for accessing the field via an interface.
+func (v *CheckAnnotationFieldsLocationCheckAnnotationSpanStartCheckAnnotationPosition) GetLine() int {
+	return v.Line
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnection includes the requested fields of the GraphQL type PullRequestConnection.
+// Represents an annotation's information level.
+type CheckAnnotationLevel string
+
+const (
+	// An annotation indicating an inescapable error.
+	CheckAnnotationLevelFailure CheckAnnotationLevel = "FAILURE"
+	// An annotation indicating some information.
+	CheckAnnotationLevelNotice CheckAnnotationLevel = "NOTICE"
+	// An annotation indicating an ignorable error.
+	CheckAnnotationLevelWarning CheckAnnotationLevel = "WARNING"
+)
+
+// The possible states for a check suite or run conclusion.
+type CheckConclusionState string
+
+const (
+	// The check suite or run requires action.
+	CheckConclusionStateActionRequired CheckConclusionState = "ACTION_REQUIRED"
+	// The check suite or run has been cancelled.
+	CheckConclusionStateCancelled CheckConclusionState = "CANCELLED"
+	// The check suite or run has failed.
+	CheckConclusionStateFailure CheckConclusionState = "FAILURE"
+	// The check suite or run was neutral.
+	CheckConclusionStateNeutral CheckConclusionState = "NEUTRAL"
+	// The check suite or run was skipped.
+	CheckConclusionStateSkipped CheckConclusionState = "SKIPPED"
+	// The check suite or run was marked stale by GitHub. Only GitHub can use this conclusion.
+	CheckConclusionStateStale CheckConclusionState = "STALE"
+	// The check suite or run has failed at startup.
+	CheckConclusionStateStartupFailure CheckConclusionState = "STARTUP_FAILURE"
+	// The check suite or run has succeeded.
+	CheckConclusionStateSuccess CheckConclusionState = "SUCCESS"
+	// The check suite or run has timed out.
+	CheckConclusionStateTimedOut CheckConclusionState = "TIMED_OUT"
+)
+
+// CheckRunFields includes the GraphQL fields of CheckRun requested by the fragment CheckRunFields.
 // The GraphQL type's documentation follows.
 //
-// The connection type for PullRequest.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnection struct {
+// A check run.
+type CheckRunFields struct {
+	Id string `json:"id"`
+	// The name of the check for this check run.
+	Name string `json:"name"`
+	// The current status of the check run.
+	Status CheckStatusState `json:"status"`
+	// The conclusion of the check run.
+	Conclusion CheckConclusionState `json:"conclusion"`
+	// A string representing the check run
+	Title string `json:"title"`
+	// A string representing the check run's summary
+	Summary string `json:"summary"`
+	// The URL from which to find full details of the check run on the integrator's site.
+	DetailsUrl string `json:"detailsUrl"`
+	// The check run's annotations
+	Annotations *CheckRunFieldsAnnotationsCheckAnnotationConnection `json:"annotations"`
+}
+
+// GetId returns CheckRunFields.Id, and is useful for accessing the field via an interface.
+func (v *CheckRunFields) GetId() string { return v.Id }
+
+// GetName returns CheckRunFields.Name, and is useful for accessing the field via an interface.
+func (v *CheckRunFields) GetName() string { return v.Name }
+
+// GetStatus returns CheckRunFields.Status, and is useful for accessing the field via an interface.
+func (v *CheckRunFields) GetStatus() CheckStatusState { return v.Status }
+
+// GetConclusion returns CheckRunFields.Conclusion, and is useful for accessing the field via an interface.
+func (v *CheckRunFields) GetConclusion() CheckConclusionState { return v.Conclusion }
+
+// GetTitle returns CheckRunFields.Title, and is useful for accessing the field via an interface.
+func (v *CheckRunFields) GetTitle() string { return v.Title }
+
+// GetSummary returns CheckRunFields.Summary, and is useful for accessing the field via an interface.
+func (v *CheckRunFields) GetSummary() string { return v.Summary }
+
+// GetDetailsUrl returns CheckRunFields.DetailsUrl, and is useful for accessing the field via an interface.
+func (v *CheckRunFields) GetDetailsUrl() string { return v.DetailsUrl }
+
+// GetAnnotations returns CheckRunFields.Annotations, and is useful for accessing the field via an interface.
+func (v *CheckRunFields) GetAnnotations() *CheckRunFieldsAnnotationsCheckAnnotationConnection {
+	return v.Annotations
+}
+
+// CheckRunFieldsAnnotationsCheckAnnotationConnection includes the requested fields of the GraphQL type CheckAnnotationConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for CheckAnnotation.
+type CheckRunFieldsAnnotationsCheckAnnotationConnection struct {
 	// A list of nodes.
-	Nodes []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest `json:"nodes"`
+	Nodes []*CheckAnnotationFields `json:"nodes"`
 }
 
-// GetNodes returns getRepositoryInfoRepositoryPullRequestsPullRequestConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnection) GetNodes() []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest {
+// GetNodes returns CheckRunFieldsAnnotationsCheckAnnotationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *CheckRunFieldsAnnotationsCheckAnnotationConnection) GetNodes() []*CheckAnnotationFields {
 	return v.Nodes
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The possible states for a check suite or run status.
+type CheckStatusState string
+
+const (
+	// The check suite or run has been completed.
+	CheckStatusStateCompleted CheckStatusState = "COMPLETED"
+	// The check suite or run is in progress.
+	CheckStatusStateInProgress CheckStatusState = "IN_PROGRESS"
+	// The check suite or run is in pending state.
+	CheckStatusStatePending CheckStatusState = "PENDING"
+	// The check suite or run has been queued.
+	CheckStatusStateQueued CheckStatusState = "QUEUED"
+	// The check suite or run has been requested.
+	CheckStatusStateRequested CheckStatusState = "REQUESTED"
+	// The check suite or run is in waiting state.
+	CheckStatusStateWaiting CheckStatusState = "WAITING"
+)
+
+// The possible sides of a diff.
+type DiffSide string
+
+const (
+	// The left side of the diff.
+	DiffSideLeft DiffSide = "LEFT"
+	// The right side of the diff.
+	DiffSideRight DiffSide = "RIGHT"
+)
+
+// PageInfoFields includes the GraphQL fields of PageInfo requested by the fragment PageInfoFields.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type PageInfoFields struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns PageInfoFields.HasNextPage, and is useful for accessing the field via an interface.
+func (v *PageInfoFields) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns PageInfoFields.EndCursor, and is useful for accessing the field via an interface.
+func (v *PageInfoFields) GetEndCursor() string { return v.EndCursor }
+
+// PullRequestFields includes the GraphQL fields of PullRequest requested by the fragment PullRequestFields.
 // The GraphQL type's documentation follows.
 //
 // A repository pull request.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest struct {
+type PullRequestFields struct {
 	// The HTTP URL for this pull request.
 	Url string `json:"url"`
 	Id  string `json:"id"`
+	// Identifies the pull request number.
+	Number int `json:"number"`
 	// Identifies if the pull request is a draft.
 	IsDraft bool `json:"isDraft"`
+	// The current status of this pull request with respect to code review.
+	ReviewDecision PullRequestReviewDecision `json:"reviewDecision"`
+	// The repository associated with this node.
+	Repository *PullRequestFieldsRepository `json:"repository"`
 	// The actor who authored the comment.
-	Author getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor `json:"-"`
+	Author PullRequestFieldsAuthorActor `json:"-"`
 	// Identifies the date and time when the object was created.
 	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The number of additions in this pull request.
+	Additions int `json:"additions"`
+	// The number of deletions in this pull request.
+	Deletions int `json:"deletions"`
+	// Identifies the oid of the head ref associated with the pull request, even if the ref has been deleted.
+	HeadRefOid string `json:"headRefOid"`
+	// A list of labels associated with the object.
+	Labels *PullRequestFieldsLabelsLabelConnection `json:"labels"`
+	// A list of commits present in this pull request's head branch not present in the base branch.
+	Commits *PullRequestFieldsCommitsPullRequestCommitConnection `json:"commits"`
 	// A list of latest reviews per user associated with the pull request that are not also pending review.
-	LatestReviews *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnection `json:"latestReviews"`
+	LatestReviews *PullRequestFieldsLatestReviewsPullRequestReviewConnection `json:"latestReviews"`
 	// Identifies the pull request title.
 	Title string `json:"title"`
 	// A list of review requests associated with the pull request.
-	ReviewRequests *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnection `json:"reviewRequests"`
+	ReviewRequests *PullRequestFieldsReviewRequestsReviewRequestConnection `json:"reviewRequests"`
+	// The list of all review threads for this pull request.
+	ReviewThreads *PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection `json:"reviewThreads"`
 }
 
-// GetUrl returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Url, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetUrl() string {
-	return v.Url
-}
+// GetUrl returns PullRequestFields.Url, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetUrl() string { return v.Url }
 
-// GetId returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Id, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetId() string {
-	return v.Id
-}
+// GetId returns PullRequestFields.Id, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetId() string { return v.Id }
 
-// GetIsDraft returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.IsDraft, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetIsDraft() bool {
-	return v.IsDraft
-}
+// GetNumber returns PullRequestFields.Number, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetNumber() int { return v.Number }
 
-// GetAuthor returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Author, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetAuthor() getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor {
-	return v.Author
-}
+// GetIsDraft returns PullRequestFields.IsDraft, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetIsDraft() bool { return v.IsDraft }
+
+// GetReviewDecision returns PullRequestFields.ReviewDecision, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetReviewDecision() PullRequestReviewDecision { return v.ReviewDecision }
+
+// GetRepository returns PullRequestFields.Repository, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetRepository() *PullRequestFieldsRepository { return v.Repository }
+
+// GetAuthor returns PullRequestFields.Author, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetAuthor() PullRequestFieldsAuthorActor { return v.Author }
+
+// GetCreatedAt returns PullRequestFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns PullRequestFields.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetAdditions returns PullRequestFields.Additions, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetAdditions() int { return v.Additions }
+
+// GetDeletions returns PullRequestFields.Deletions, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetDeletions() int { return v.Deletions }
+
+// GetHeadRefOid returns PullRequestFields.HeadRefOid, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetHeadRefOid() string { return v.HeadRefOid }
 
-// GetCreatedAt returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.CreatedAt, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetCreatedAt() time.Time {
-	return v.CreatedAt
+// GetLabels returns PullRequestFields.Labels, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetLabels() *PullRequestFieldsLabelsLabelConnection { return v.Labels }
+
+// GetCommits returns PullRequestFields.Commits, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetCommits() *PullRequestFieldsCommitsPullRequestCommitConnection {
+	return v.Commits
 }
 
-// GetLatestReviews returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.LatestReviews, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetLatestReviews() *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnection {
+// GetLatestReviews returns PullRequestFields.LatestReviews, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetLatestReviews() *PullRequestFieldsLatestReviewsPullRequestReviewConnection {
 	return v.LatestReviews
 }
 
-// GetTitle returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Title, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetTitle() string {
-	return v.Title
-}
+// GetTitle returns PullRequestFields.Title, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetTitle() string { return v.Title }
 
-// GetReviewRequests returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.ReviewRequests, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetReviewRequests() *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnection {
+// GetReviewRequests returns PullRequestFields.ReviewRequests, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetReviewRequests() *PullRequestFieldsReviewRequestsReviewRequestConnection {
 	return v.ReviewRequests
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) UnmarshalJSON(b []byte) error {
+// GetReviewThreads returns PullRequestFields.ReviewThreads, and is useful for accessing the field via an interface.
+func (v *PullRequestFields) GetReviewThreads() *PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection {
+	return v.ReviewThreads
+}
+
+func (v *PullRequestFields) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest
+		*PullRequestFields
 		Author json.RawMessage `json:"author"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest = v
+	firstPass.PullRequestFields = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -151,36 +342,56 @@ func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRe
 		dst := &v.Author
 		src := firstPass.Author
 		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor(
+			err = __unmarshalPullRequestFieldsAuthorActor(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Author: %w", err)
+					"Unable to unmarshal PullRequestFields.Author: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest struct {
+type __premarshalPullRequestFields struct {
 	Url string `json:"url"`
 
 	Id string `json:"id"`
 
+	Number int `json:"number"`
+
 	IsDraft bool `json:"isDraft"`
 
+	ReviewDecision PullRequestReviewDecision `json:"reviewDecision"`
+
+	Repository *PullRequestFieldsRepository `json:"repository"`
+
 	Author json.RawMessage `json:"author"`
 
 	CreatedAt time.Time `json:"createdAt"`
 
-	LatestReviews *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnection `json:"latestReviews"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Additions int `json:"additions"`
+
+	Deletions int `json:"deletions"`
+
+	HeadRefOid string `json:"headRefOid"`
+
+	Labels *PullRequestFieldsLabelsLabelConnection `json:"labels"`
+
+	Commits *PullRequestFieldsCommitsPullRequestCommitConnection `json:"commits"`
+
+	LatestReviews *PullRequestFieldsLatestReviewsPullRequestReviewConnection `json:"latestReviews"`
 
 	Title string `json:"title"`
 
-	ReviewRequests *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnection `json:"reviewRequests"`
+	ReviewRequests *PullRequestFieldsReviewRequestsReviewRequestConnection `json:"reviewRequests"`
+
+	ReviewThreads *PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection `json:"reviewThreads"`
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) MarshalJSON() ([]byte, error) {
+func (v *PullRequestFields) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -188,44 +399,54 @@ func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRe
 	return json.Marshal(premarshaled)
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest) __premarshalJSON() (*__premarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest, error) {
-	var retval __premarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest
+func (v *PullRequestFields) __premarshalJSON() (*__premarshalPullRequestFields, error) {
+	var retval __premarshalPullRequestFields
 
 	retval.Url = v.Url
 	retval.Id = v.Id
+	retval.Number = v.Number
 	retval.IsDraft = v.IsDraft
+	retval.ReviewDecision = v.ReviewDecision
+	retval.Repository = v.Repository
 	{
 
 		dst := &retval.Author
 		src := v.Author
 		var err error
-		*dst, err = __marshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor(
+		*dst, err = __marshalPullRequestFieldsAuthorActor(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"Unable to marshal getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Author: %w", err)
+				"Unable to marshal PullRequestFields.Author: %w", err)
 		}
 	}
 	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.Additions = v.Additions
+	retval.Deletions = v.Deletions
+	retval.HeadRefOid = v.HeadRefOid
+	retval.Labels = v.Labels
+	retval.Commits = v.Commits
 	retval.LatestReviews = v.LatestReviews
 	retval.Title = v.Title
 	retval.ReviewRequests = v.ReviewRequests
+	retval.ReviewThreads = v.ReviewThreads
 	return &retval, nil
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor includes the requested fields of the GraphQL interface Actor.
+// PullRequestFieldsAuthorActor includes the requested fields of the GraphQL interface Actor.
 //
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor is implemented by the following types:
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser
+// PullRequestFieldsAuthorActor is implemented by the following types:
+// PullRequestFieldsAuthorBot
+// PullRequestFieldsAuthorEnterpriseUserAccount
+// PullRequestFieldsAuthorMannequin
+// PullRequestFieldsAuthorOrganization
+// PullRequestFieldsAuthorUser
 // The GraphQL type's documentation follows.
 //
 // Represents an object which can take actions on GitHub. Typically a User or Bot.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor interface {
-	implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor()
+type PullRequestFieldsAuthorActor interface {
+	implementsGraphQLInterfacePullRequestFieldsAuthorActor()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() string
 	// GetLogin returns the interface-field "login" from its implementation.
@@ -235,18 +456,15 @@ type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullReques
 	GetLogin() string
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor() {
-}
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor() {
-}
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor() {
+func (v *PullRequestFieldsAuthorBot) implementsGraphQLInterfacePullRequestFieldsAuthorActor() {}
+func (v *PullRequestFieldsAuthorEnterpriseUserAccount) implementsGraphQLInterfacePullRequestFieldsAuthorActor() {
 }
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor() {
-}
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor() {
+func (v *PullRequestFieldsAuthorMannequin) implementsGraphQLInterfacePullRequestFieldsAuthorActor() {}
+func (v *PullRequestFieldsAuthorOrganization) implementsGraphQLInterfacePullRequestFieldsAuthorActor() {
 }
+func (v *PullRequestFieldsAuthorUser) implementsGraphQLInterfacePullRequestFieldsAuthorActor() {}
 
-func __unmarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor(b []byte, v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor) error {
+func __unmarshalPullRequestFieldsAuthorActor(b []byte, v *PullRequestFieldsAuthorActor) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -261,228 +479,415 @@ func __unmarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNode
 
 	switch tn.TypeName {
 	case "Bot":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot)
+		*v = new(PullRequestFieldsAuthorBot)
 		return json.Unmarshal(b, *v)
 	case "EnterpriseUserAccount":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount)
+		*v = new(PullRequestFieldsAuthorEnterpriseUserAccount)
 		return json.Unmarshal(b, *v)
 	case "Mannequin":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin)
+		*v = new(PullRequestFieldsAuthorMannequin)
 		return json.Unmarshal(b, *v)
 	case "Organization":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization)
+		*v = new(PullRequestFieldsAuthorOrganization)
 		return json.Unmarshal(b, *v)
 	case "User":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser)
+		*v = new(PullRequestFieldsAuthorUser)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing Actor.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor: "%v"`, tn.TypeName)
+			`unexpected concrete type for PullRequestFieldsAuthorActor: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor(v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor) ([]byte, error) {
+func __marshalPullRequestFieldsAuthorActor(v *PullRequestFieldsAuthorActor) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot:
+	case *PullRequestFieldsAuthorBot:
 		typename = "Bot"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot
+			*PullRequestFieldsAuthorBot
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount:
+	case *PullRequestFieldsAuthorEnterpriseUserAccount:
 		typename = "EnterpriseUserAccount"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount
+			*PullRequestFieldsAuthorEnterpriseUserAccount
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin:
+	case *PullRequestFieldsAuthorMannequin:
 		typename = "Mannequin"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin
+			*PullRequestFieldsAuthorMannequin
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization:
+	case *PullRequestFieldsAuthorOrganization:
 		typename = "Organization"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization
+			*PullRequestFieldsAuthorOrganization
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser:
+	case *PullRequestFieldsAuthorUser:
 		typename = "User"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser
+			*PullRequestFieldsAuthorUser
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorActor: "%T"`, v)
+			`unexpected concrete type for PullRequestFieldsAuthorActor: "%T"`, v)
 	}
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot includes the requested fields of the GraphQL type Bot.
+// PullRequestFieldsAuthorBot includes the requested fields of the GraphQL type Bot.
 // The GraphQL type's documentation follows.
 //
 // A special type of user which takes actions on behalf of GitHub Apps.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot struct {
+type PullRequestFieldsAuthorBot struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns PullRequestFieldsAuthorBot.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorBot) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorBot) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns PullRequestFieldsAuthorBot.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorBot) GetLogin() string { return v.Login }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// PullRequestFieldsAuthorEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
 // The GraphQL type's documentation follows.
 //
 // An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount struct {
+type PullRequestFieldsAuthorEnterpriseUserAccount struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns PullRequestFieldsAuthorEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorEnterpriseUserAccount) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorEnterpriseUserAccount) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns PullRequestFieldsAuthorEnterpriseUserAccount.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorEnterpriseUserAccount) GetLogin() string { return v.Login }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin includes the requested fields of the GraphQL type Mannequin.
+// PullRequestFieldsAuthorMannequin includes the requested fields of the GraphQL type Mannequin.
 // The GraphQL type's documentation follows.
 //
 // A placeholder user for attribution of imported data on GitHub.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin struct {
+type PullRequestFieldsAuthorMannequin struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns PullRequestFieldsAuthorMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorMannequin) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorMannequin) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns PullRequestFieldsAuthorMannequin.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorMannequin) GetLogin() string { return v.Login }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization includes the requested fields of the GraphQL type Organization.
+// PullRequestFieldsAuthorOrganization includes the requested fields of the GraphQL type Organization.
 // The GraphQL type's documentation follows.
 //
 // An account on GitHub, with one or more owners, that has repositories, members and teams.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization struct {
+type PullRequestFieldsAuthorOrganization struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns PullRequestFieldsAuthorOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorOrganization) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorOrganization) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns PullRequestFieldsAuthorOrganization.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorOrganization) GetLogin() string { return v.Login }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser includes the requested fields of the GraphQL type User.
+// PullRequestFieldsAuthorUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
 // A user is an individual's account on GitHub that owns repositories and can make new content.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser struct {
+type PullRequestFieldsAuthorUser struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser) GetTypename() string {
-	return v.Typename
+// GetTypename returns PullRequestFieldsAuthorUser.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorUser) GetTypename() string { return v.Typename }
+
+// GetLogin returns PullRequestFieldsAuthorUser.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsAuthorUser) GetLogin() string { return v.Login }
+
+// PullRequestFieldsCommitsPullRequestCommitConnection includes the requested fields of the GraphQL type PullRequestCommitConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequestCommit.
+type PullRequestFieldsCommitsPullRequestCommitConnection struct {
+	// A list of nodes.
+	Nodes []*PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommit `json:"nodes"`
 }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestAuthorUser) GetLogin() string {
-	return v.Login
+// GetNodes returns PullRequestFieldsCommitsPullRequestCommitConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsCommitsPullRequestCommitConnection) GetNodes() []*PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommit {
+	return v.Nodes
+}
+
+// PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommit includes the requested fields of the GraphQL type PullRequestCommit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit part of a pull request.
+type PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommit struct {
+	// The Git commit object
+	Commit *PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit `json:"commit"`
+}
+
+// GetCommit returns PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommit.Commit, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommit) GetCommit() *PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit {
+	return v.Commit
+}
+
+// PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit struct {
+	// The Git object ID
+	Oid string `json:"oid"`
+	// The datetime when this commit was committed.
+	CommittedDate time.Time `json:"committedDate"`
+	// Check and Status rollup information for this commit.
+	StatusCheckRollup *PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatusCheckRollup `json:"statusCheckRollup"`
+}
+
+// GetOid returns PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit.Oid, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit) GetOid() string {
+	return v.Oid
+}
+
+// GetCommittedDate returns PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit.CommittedDate, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit) GetCommittedDate() time.Time {
+	return v.CommittedDate
+}
+
+// GetStatusCheckRollup returns PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit.StatusCheckRollup, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit) GetStatusCheckRollup() *PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatusCheckRollup {
+	return v.StatusCheckRollup
+}
+
+// PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
+// The GraphQL type's documentation follows.
+//
+// Represents the rollup for both the check runs and status for a commit.
+type PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatusCheckRollup struct {
+	// The combined status for the commit.
+	State StatusState `json:"state"`
+}
+
+// GetState returns PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatusCheckRollup.State, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatusCheckRollup) GetState() StatusState {
+	return v.State
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnection includes the requested fields of the GraphQL type PullRequestReviewConnection.
+// PullRequestFieldsLabelsLabelConnection includes the requested fields of the GraphQL type LabelConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Label.
+type PullRequestFieldsLabelsLabelConnection struct {
+	// A list of nodes.
+	Nodes []*PullRequestFieldsLabelsLabelConnectionNodesLabel `json:"nodes"`
+}
+
+// GetNodes returns PullRequestFieldsLabelsLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsLabelsLabelConnection) GetNodes() []*PullRequestFieldsLabelsLabelConnectionNodesLabel {
+	return v.Nodes
+}
+
+// PullRequestFieldsLabelsLabelConnectionNodesLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type PullRequestFieldsLabelsLabelConnectionNodesLabel struct {
+	// Identifies the label name.
+	Name string `json:"name"`
+}
+
+// GetName returns PullRequestFieldsLabelsLabelConnectionNodesLabel.Name, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsLabelsLabelConnectionNodesLabel) GetName() string { return v.Name }
+
+// PullRequestFieldsLatestReviewsPullRequestReviewConnection includes the requested fields of the GraphQL type PullRequestReviewConnection.
 // The GraphQL type's documentation follows.
 //
 // The connection type for PullRequestReview.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnection struct {
+type PullRequestFieldsLatestReviewsPullRequestReviewConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfoFields `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []*PullRequestReviewFields `json:"nodes"`
+}
+
+// GetPageInfo returns PullRequestFieldsLatestReviewsPullRequestReviewConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsLatestReviewsPullRequestReviewConnection) GetPageInfo() *PageInfoFields {
+	return v.PageInfo
+}
+
+// GetNodes returns PullRequestFieldsLatestReviewsPullRequestReviewConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsLatestReviewsPullRequestReviewConnection) GetNodes() []*PullRequestReviewFields {
+	return v.Nodes
+}
+
+// PullRequestFieldsRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type PullRequestFieldsRepository struct {
+	// The HTTP URL for this repository
+	Url string `json:"url"`
+	// The repository's name with owner.
+	NameWithOwner string `json:"nameWithOwner"`
+}
+
+// GetUrl returns PullRequestFieldsRepository.Url, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsRepository) GetUrl() string { return v.Url }
+
+// GetNameWithOwner returns PullRequestFieldsRepository.NameWithOwner, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsRepository) GetNameWithOwner() string { return v.NameWithOwner }
+
+// PullRequestFieldsReviewRequestsReviewRequestConnection includes the requested fields of the GraphQL type ReviewRequestConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for ReviewRequest.
+type PullRequestFieldsReviewRequestsReviewRequestConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfoFields `json:"pageInfo"`
 	// A list of nodes.
-	Nodes []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview `json:"nodes"`
+	Nodes []*ReviewRequestFields `json:"nodes"`
 }
 
-// GetNodes returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnection) GetNodes() []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview {
+// GetPageInfo returns PullRequestFieldsReviewRequestsReviewRequestConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsReviewRequestsReviewRequestConnection) GetPageInfo() *PageInfoFields {
+	return v.PageInfo
+}
+
+// GetNodes returns PullRequestFieldsReviewRequestsReviewRequestConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsReviewRequestsReviewRequestConnection) GetNodes() []*ReviewRequestFields {
+	return v.Nodes
+}
+
+// PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection includes the requested fields of the GraphQL type PullRequestReviewThreadConnection.
+// The GraphQL type's documentation follows.
+//
+// Review comment threads for a pull request review.
+type PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection struct {
+	// A list of nodes.
+	Nodes []*PullRequestFieldsReviewThreadsPullRequestReviewThreadConnectionNodesPullRequestReviewThread `json:"nodes"`
+}
+
+// GetNodes returns PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection) GetNodes() []*PullRequestFieldsReviewThreadsPullRequestReviewThreadConnectionNodesPullRequestReviewThread {
 	return v.Nodes
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// PullRequestFieldsReviewThreadsPullRequestReviewThreadConnectionNodesPullRequestReviewThread includes the requested fields of the GraphQL type PullRequestReviewThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type PullRequestFieldsReviewThreadsPullRequestReviewThreadConnectionNodesPullRequestReviewThread struct {
+	Id string `json:"id"`
+	// Whether this thread has been resolved
+	IsResolved bool `json:"isResolved"`
+}
+
+// GetId returns PullRequestFieldsReviewThreadsPullRequestReviewThreadConnectionNodesPullRequestReviewThread.Id, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsReviewThreadsPullRequestReviewThreadConnectionNodesPullRequestReviewThread) GetId() string {
+	return v.Id
+}
+
+// GetIsResolved returns PullRequestFieldsReviewThreadsPullRequestReviewThreadConnectionNodesPullRequestReviewThread.IsResolved, and is useful for accessing the field via an interface.
+func (v *PullRequestFieldsReviewThreadsPullRequestReviewThreadConnectionNodesPullRequestReviewThread) GetIsResolved() bool {
+	return v.IsResolved
+}
+
+// The review status of a pull request.
+type PullRequestReviewDecision string
+
+const (
+	// The pull request has received an approving review.
+	PullRequestReviewDecisionApproved PullRequestReviewDecision = "APPROVED"
+	// Changes have been requested on the pull request.
+	PullRequestReviewDecisionChangesRequested PullRequestReviewDecision = "CHANGES_REQUESTED"
+	// A review is required before the pull request can be merged.
+	PullRequestReviewDecisionReviewRequired PullRequestReviewDecision = "REVIEW_REQUIRED"
+)
+
+// The possible events to perform on a pull request review.
+type PullRequestReviewEvent string
+
+const (
+	// Submit feedback and approve merging these changes.
+	PullRequestReviewEventApprove PullRequestReviewEvent = "APPROVE"
+	// Submit general feedback without explicit approval.
+	PullRequestReviewEventComment PullRequestReviewEvent = "COMMENT"
+	// Dismiss review so it now longer effects merging.
+	PullRequestReviewEventDismiss PullRequestReviewEvent = "DISMISS"
+	// Submit feedback that must be addressed before merging.
+	PullRequestReviewEventRequestChanges PullRequestReviewEvent = "REQUEST_CHANGES"
+)
+
+// PullRequestReviewFields includes the GraphQL fields of PullRequestReview requested by the fragment PullRequestReviewFields.
 // The GraphQL type's documentation follows.
 //
 // A review object for a given pull request.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview struct {
+type PullRequestReviewFields struct {
 	// Identifies the current state of the pull request review.
 	State PullRequestReviewState `json:"state"`
 	// The actor who authored the comment.
-	Author getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor `json:"-"`
+	Author PullRequestReviewFieldsAuthorActor `json:"-"`
+	// Identifies when the Pull Request Review was submitted
+	SubmittedAt time.Time `json:"submittedAt"`
+	// Identifies the commit associated with this pull request review.
+	Commit *PullRequestReviewFieldsCommit `json:"commit"`
 }
 
-// GetState returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview.State, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview) GetState() PullRequestReviewState {
-	return v.State
-}
+// GetState returns PullRequestReviewFields.State, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFields) GetState() PullRequestReviewState { return v.State }
 
-// GetAuthor returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview.Author, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview) GetAuthor() getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor {
-	return v.Author
-}
+// GetAuthor returns PullRequestReviewFields.Author, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFields) GetAuthor() PullRequestReviewFieldsAuthorActor { return v.Author }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview) UnmarshalJSON(b []byte) error {
+// GetSubmittedAt returns PullRequestReviewFields.SubmittedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFields) GetSubmittedAt() time.Time { return v.SubmittedAt }
+
+// GetCommit returns PullRequestReviewFields.Commit, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFields) GetCommit() *PullRequestReviewFieldsCommit { return v.Commit }
+
+func (v *PullRequestReviewFields) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview
+		*PullRequestReviewFields
 		Author json.RawMessage `json:"author"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview = v
+	firstPass.PullRequestReviewFields = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -493,24 +898,28 @@ func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRe
 		dst := &v.Author
 		src := firstPass.Author
 		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor(
+			err = __unmarshalPullRequestReviewFieldsAuthorActor(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview.Author: %w", err)
+					"Unable to unmarshal PullRequestReviewFields.Author: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview struct {
+type __premarshalPullRequestReviewFields struct {
 	State PullRequestReviewState `json:"state"`
 
 	Author json.RawMessage `json:"author"`
+
+	SubmittedAt time.Time `json:"submittedAt"`
+
+	Commit *PullRequestReviewFieldsCommit `json:"commit"`
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview) MarshalJSON() ([]byte, error) {
+func (v *PullRequestReviewFields) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -518,8 +927,8 @@ func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRe
 	return json.Marshal(premarshaled)
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview) __premarshalJSON() (*__premarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview, error) {
-	var retval __premarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview
+func (v *PullRequestReviewFields) __premarshalJSON() (*__premarshalPullRequestReviewFields, error) {
+	var retval __premarshalPullRequestReviewFields
 
 	retval.State = v.State
 	{
@@ -527,29 +936,31 @@ func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRe
 		dst := &retval.Author
 		src := v.Author
 		var err error
-		*dst, err = __marshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor(
+		*dst, err = __marshalPullRequestReviewFieldsAuthorActor(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"Unable to marshal getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReview.Author: %w", err)
+				"Unable to marshal PullRequestReviewFields.Author: %w", err)
 		}
 	}
+	retval.SubmittedAt = v.SubmittedAt
+	retval.Commit = v.Commit
 	return &retval, nil
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor includes the requested fields of the GraphQL interface Actor.
+// PullRequestReviewFieldsAuthorActor includes the requested fields of the GraphQL interface Actor.
 //
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor is implemented by the following types:
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser
+// PullRequestReviewFieldsAuthorActor is implemented by the following types:
+// PullRequestReviewFieldsAuthorBot
+// PullRequestReviewFieldsAuthorEnterpriseUserAccount
+// PullRequestReviewFieldsAuthorMannequin
+// PullRequestReviewFieldsAuthorOrganization
+// PullRequestReviewFieldsAuthorUser
 // The GraphQL type's documentation follows.
 //
 // Represents an object which can take actions on GitHub. Typically a User or Bot.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor interface {
-	implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor()
+type PullRequestReviewFieldsAuthorActor interface {
+	implementsGraphQLInterfacePullRequestReviewFieldsAuthorActor()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() string
 	// GetLogin returns the interface-field "login" from its implementation.
@@ -559,18 +970,18 @@ type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullReques
 	GetLogin() string
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor() {
+func (v *PullRequestReviewFieldsAuthorBot) implementsGraphQLInterfacePullRequestReviewFieldsAuthorActor() {
 }
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor() {
+func (v *PullRequestReviewFieldsAuthorEnterpriseUserAccount) implementsGraphQLInterfacePullRequestReviewFieldsAuthorActor() {
 }
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor() {
+func (v *PullRequestReviewFieldsAuthorMannequin) implementsGraphQLInterfacePullRequestReviewFieldsAuthorActor() {
 }
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor() {
+func (v *PullRequestReviewFieldsAuthorOrganization) implementsGraphQLInterfacePullRequestReviewFieldsAuthorActor() {
 }
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor() {
+func (v *PullRequestReviewFieldsAuthorUser) implementsGraphQLInterfacePullRequestReviewFieldsAuthorActor() {
 }
 
-func __unmarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor(b []byte, v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor) error {
+func __unmarshalPullRequestReviewFieldsAuthorActor(b []byte, v *PullRequestReviewFieldsAuthorActor) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -585,221 +996,546 @@ func __unmarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNode
 
 	switch tn.TypeName {
 	case "Bot":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot)
+		*v = new(PullRequestReviewFieldsAuthorBot)
 		return json.Unmarshal(b, *v)
 	case "EnterpriseUserAccount":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount)
+		*v = new(PullRequestReviewFieldsAuthorEnterpriseUserAccount)
 		return json.Unmarshal(b, *v)
 	case "Mannequin":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin)
+		*v = new(PullRequestReviewFieldsAuthorMannequin)
 		return json.Unmarshal(b, *v)
 	case "Organization":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization)
+		*v = new(PullRequestReviewFieldsAuthorOrganization)
 		return json.Unmarshal(b, *v)
 	case "User":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser)
+		*v = new(PullRequestReviewFieldsAuthorUser)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing Actor.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor: "%v"`, tn.TypeName)
+			`unexpected concrete type for PullRequestReviewFieldsAuthorActor: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor(v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor) ([]byte, error) {
+func __marshalPullRequestReviewFieldsAuthorActor(v *PullRequestReviewFieldsAuthorActor) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot:
+	case *PullRequestReviewFieldsAuthorBot:
 		typename = "Bot"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot
+			*PullRequestReviewFieldsAuthorBot
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount:
+	case *PullRequestReviewFieldsAuthorEnterpriseUserAccount:
 		typename = "EnterpriseUserAccount"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount
+			*PullRequestReviewFieldsAuthorEnterpriseUserAccount
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin:
+	case *PullRequestReviewFieldsAuthorMannequin:
 		typename = "Mannequin"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin
+			*PullRequestReviewFieldsAuthorMannequin
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization:
+	case *PullRequestReviewFieldsAuthorOrganization:
 		typename = "Organization"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization
+			*PullRequestReviewFieldsAuthorOrganization
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser:
+	case *PullRequestReviewFieldsAuthorUser:
 		typename = "User"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser
+			*PullRequestReviewFieldsAuthorUser
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorActor: "%T"`, v)
+			`unexpected concrete type for PullRequestReviewFieldsAuthorActor: "%T"`, v)
 	}
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot includes the requested fields of the GraphQL type Bot.
+// PullRequestReviewFieldsAuthorBot includes the requested fields of the GraphQL type Bot.
 // The GraphQL type's documentation follows.
 //
 // A special type of user which takes actions on behalf of GitHub Apps.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot struct {
+type PullRequestReviewFieldsAuthorBot struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns PullRequestReviewFieldsAuthorBot.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorBot) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorBot) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns PullRequestReviewFieldsAuthorBot.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorBot) GetLogin() string { return v.Login }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// PullRequestReviewFieldsAuthorEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
 // The GraphQL type's documentation follows.
 //
 // An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount struct {
+type PullRequestReviewFieldsAuthorEnterpriseUserAccount struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns PullRequestReviewFieldsAuthorEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorEnterpriseUserAccount) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorEnterpriseUserAccount) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns PullRequestReviewFieldsAuthorEnterpriseUserAccount.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorEnterpriseUserAccount) GetLogin() string { return v.Login }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin includes the requested fields of the GraphQL type Mannequin.
+// PullRequestReviewFieldsAuthorMannequin includes the requested fields of the GraphQL type Mannequin.
 // The GraphQL type's documentation follows.
 //
 // A placeholder user for attribution of imported data on GitHub.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin struct {
+type PullRequestReviewFieldsAuthorMannequin struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns PullRequestReviewFieldsAuthorMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorMannequin) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorMannequin) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns PullRequestReviewFieldsAuthorMannequin.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorMannequin) GetLogin() string { return v.Login }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization includes the requested fields of the GraphQL type Organization.
+// PullRequestReviewFieldsAuthorOrganization includes the requested fields of the GraphQL type Organization.
 // The GraphQL type's documentation follows.
 //
 // An account on GitHub, with one or more owners, that has repositories, members and teams.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization struct {
+type PullRequestReviewFieldsAuthorOrganization struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns PullRequestReviewFieldsAuthorOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorOrganization) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorOrganization) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns PullRequestReviewFieldsAuthorOrganization.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorOrganization) GetLogin() string { return v.Login }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser includes the requested fields of the GraphQL type User.
+// PullRequestReviewFieldsAuthorUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
 // A user is an individual's account on GitHub that owns repositories and can make new content.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser struct {
+type PullRequestReviewFieldsAuthorUser struct {
 	Typename string `json:"__typename"`
 	// The username of the actor.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns PullRequestReviewFieldsAuthorUser.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorUser) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestLatestReviewsPullRequestReviewConnectionNodesPullRequestReviewAuthorUser) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns PullRequestReviewFieldsAuthorUser.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsAuthorUser) GetLogin() string { return v.Login }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnection includes the requested fields of the GraphQL type ReviewRequestConnection.
+// PullRequestReviewFieldsCommit includes the requested fields of the GraphQL type Commit.
 // The GraphQL type's documentation follows.
 //
-// The connection type for ReviewRequest.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnection struct {
-	// A list of nodes.
-	Nodes []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest `json:"nodes"`
+// Represents a Git commit.
+type PullRequestReviewFieldsCommit struct {
+	// The Git object ID
+	Oid string `json:"oid"`
+	// The datetime when this commit was committed.
+	CommittedDate time.Time `json:"committedDate"`
 }
 
-// GetNodes returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnection) GetNodes() []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest {
-	return v.Nodes
-}
+// GetOid returns PullRequestReviewFieldsCommit.Oid, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsCommit) GetOid() string { return v.Oid }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest includes the requested fields of the GraphQL type ReviewRequest.
-// The GraphQL type's documentation follows.
-//
-// A request for a user to review a pull request.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest struct {
-	// The reviewer that is requested.
-	RequestedReviewer getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer `json:"-"`
+// GetCommittedDate returns PullRequestReviewFieldsCommit.CommittedDate, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewFieldsCommit) GetCommittedDate() time.Time { return v.CommittedDate }
+
+// The possible states of a pull request review.
+type PullRequestReviewState string
+
+const (
+	// A review allowing the pull request to merge.
+	PullRequestReviewStateApproved PullRequestReviewState = "APPROVED"
+	// A review blocking the pull request from merging.
+	PullRequestReviewStateChangesRequested PullRequestReviewState = "CHANGES_REQUESTED"
+	// An informational review.
+	PullRequestReviewStateCommented PullRequestReviewState = "COMMENTED"
+	// A review that has been dismissed.
+	PullRequestReviewStateDismissed PullRequestReviewState = "DISMISSED"
+	// A review that has not yet been submitted.
+	PullRequestReviewStatePending PullRequestReviewState = "PENDING"
+)
+
+// RateLimitFields includes the GraphQL fields of RateLimit requested by the fragment RateLimitFields.
+// The GraphQL type's documentation follows.
+//
+// Represents the client's rate limit.
+type RateLimitFields struct {
+	// The maximum number of points the client is permitted to consume in a 60 minute window.
+	Limit int `json:"limit"`
+	// The number of points remaining in the current rate limit window.
+	Remaining int `json:"remaining"`
+	// The time at which the current rate limit window resets in UTC epoch seconds.
+	ResetAt time.Time `json:"resetAt"`
+	// The point cost for the current query counting against the rate limit.
+	Cost int `json:"cost"`
+}
+
+// GetLimit returns RateLimitFields.Limit, and is useful for accessing the field via an interface.
+func (v *RateLimitFields) GetLimit() int { return v.Limit }
+
+// GetRemaining returns RateLimitFields.Remaining, and is useful for accessing the field via an interface.
+func (v *RateLimitFields) GetRemaining() int { return v.Remaining }
+
+// GetResetAt returns RateLimitFields.ResetAt, and is useful for accessing the field via an interface.
+func (v *RateLimitFields) GetResetAt() time.Time { return v.ResetAt }
+
+// GetCost returns RateLimitFields.Cost, and is useful for accessing the field via an interface.
+func (v *RateLimitFields) GetCost() int { return v.Cost }
+
+// ReviewCommentFields includes the GraphQL fields of PullRequestReviewComment requested by the fragment ReviewCommentFields.
+// The GraphQL type's documentation follows.
+//
+// A review comment associated with a given repository pull request.
+type ReviewCommentFields struct {
+	Id string `json:"id"`
+	// The actor who authored the comment.
+	Author ReviewCommentFieldsAuthorActor `json:"-"`
+	// The comment body of this review comment.
+	Body string `json:"body"`
+	// Identifies when the comment was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetId returns ReviewCommentFields.Id, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFields) GetId() string { return v.Id }
+
+// GetAuthor returns ReviewCommentFields.Author, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFields) GetAuthor() ReviewCommentFieldsAuthorActor { return v.Author }
+
+// GetBody returns ReviewCommentFields.Body, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFields) GetBody() string { return v.Body }
+
+// GetCreatedAt returns ReviewCommentFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFields) GetCreatedAt() time.Time { return v.CreatedAt }
+
+func (v *ReviewCommentFields) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ReviewCommentFields
+		Author json.RawMessage `json:"author"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ReviewCommentFields = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Author
+		src := firstPass.Author
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalReviewCommentFieldsAuthorActor(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal ReviewCommentFields.Author: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalReviewCommentFields struct {
+	Id string `json:"id"`
+
+	Author json.RawMessage `json:"author"`
+
+	Body string `json:"body"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *ReviewCommentFields) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ReviewCommentFields) __premarshalJSON() (*__premarshalReviewCommentFields, error) {
+	var retval __premarshalReviewCommentFields
+
+	retval.Id = v.Id
+	{
+
+		dst := &retval.Author
+		src := v.Author
+		var err error
+		*dst, err = __marshalReviewCommentFieldsAuthorActor(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal ReviewCommentFields.Author: %w", err)
+		}
+	}
+	retval.Body = v.Body
+	retval.CreatedAt = v.CreatedAt
+	return &retval, nil
+}
+
+// ReviewCommentFieldsAuthorActor includes the requested fields of the GraphQL interface Actor.
+//
+// ReviewCommentFieldsAuthorActor is implemented by the following types:
+// ReviewCommentFieldsAuthorBot
+// ReviewCommentFieldsAuthorEnterpriseUserAccount
+// ReviewCommentFieldsAuthorMannequin
+// ReviewCommentFieldsAuthorOrganization
+// ReviewCommentFieldsAuthorUser
+// The GraphQL type's documentation follows.
+//
+// Represents an object which can take actions on GitHub. Typically a User or Bot.
+type ReviewCommentFieldsAuthorActor interface {
+	implementsGraphQLInterfaceReviewCommentFieldsAuthorActor()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetLogin returns the interface-field "login" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The username of the actor.
+	GetLogin() string
+}
+
+func (v *ReviewCommentFieldsAuthorBot) implementsGraphQLInterfaceReviewCommentFieldsAuthorActor() {}
+func (v *ReviewCommentFieldsAuthorEnterpriseUserAccount) implementsGraphQLInterfaceReviewCommentFieldsAuthorActor() {
+}
+func (v *ReviewCommentFieldsAuthorMannequin) implementsGraphQLInterfaceReviewCommentFieldsAuthorActor() {
+}
+func (v *ReviewCommentFieldsAuthorOrganization) implementsGraphQLInterfaceReviewCommentFieldsAuthorActor() {
+}
+func (v *ReviewCommentFieldsAuthorUser) implementsGraphQLInterfaceReviewCommentFieldsAuthorActor() {}
+
+func __unmarshalReviewCommentFieldsAuthorActor(b []byte, v *ReviewCommentFieldsAuthorActor) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Bot":
+		*v = new(ReviewCommentFieldsAuthorBot)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseUserAccount":
+		*v = new(ReviewCommentFieldsAuthorEnterpriseUserAccount)
+		return json.Unmarshal(b, *v)
+	case "Mannequin":
+		*v = new(ReviewCommentFieldsAuthorMannequin)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(ReviewCommentFieldsAuthorOrganization)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(ReviewCommentFieldsAuthorUser)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Actor.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ReviewCommentFieldsAuthorActor: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalReviewCommentFieldsAuthorActor(v *ReviewCommentFieldsAuthorActor) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ReviewCommentFieldsAuthorBot:
+		typename = "Bot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ReviewCommentFieldsAuthorBot
+		}{typename, v}
+		return json.Marshal(result)
+	case *ReviewCommentFieldsAuthorEnterpriseUserAccount:
+		typename = "EnterpriseUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ReviewCommentFieldsAuthorEnterpriseUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *ReviewCommentFieldsAuthorMannequin:
+		typename = "Mannequin"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ReviewCommentFieldsAuthorMannequin
+		}{typename, v}
+		return json.Marshal(result)
+	case *ReviewCommentFieldsAuthorOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ReviewCommentFieldsAuthorOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *ReviewCommentFieldsAuthorUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ReviewCommentFieldsAuthorUser
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ReviewCommentFieldsAuthorActor: "%T"`, v)
+	}
+}
+
+// ReviewCommentFieldsAuthorBot includes the requested fields of the GraphQL type Bot.
+// The GraphQL type's documentation follows.
+//
+// A special type of user which takes actions on behalf of GitHub Apps.
+type ReviewCommentFieldsAuthorBot struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns ReviewCommentFieldsAuthorBot.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorBot) GetTypename() string { return v.Typename }
+
+// GetLogin returns ReviewCommentFieldsAuthorBot.Login, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorBot) GetLogin() string { return v.Login }
+
+// ReviewCommentFieldsAuthorEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// The GraphQL type's documentation follows.
+//
+// An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
+type ReviewCommentFieldsAuthorEnterpriseUserAccount struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns ReviewCommentFieldsAuthorEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorEnterpriseUserAccount) GetTypename() string { return v.Typename }
+
+// GetLogin returns ReviewCommentFieldsAuthorEnterpriseUserAccount.Login, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorEnterpriseUserAccount) GetLogin() string { return v.Login }
+
+// ReviewCommentFieldsAuthorMannequin includes the requested fields of the GraphQL type Mannequin.
+// The GraphQL type's documentation follows.
+//
+// A placeholder user for attribution of imported data on GitHub.
+type ReviewCommentFieldsAuthorMannequin struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns ReviewCommentFieldsAuthorMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorMannequin) GetTypename() string { return v.Typename }
+
+// GetLogin returns ReviewCommentFieldsAuthorMannequin.Login, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorMannequin) GetLogin() string { return v.Login }
+
+// ReviewCommentFieldsAuthorOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type ReviewCommentFieldsAuthorOrganization struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns ReviewCommentFieldsAuthorOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorOrganization) GetTypename() string { return v.Typename }
+
+// GetLogin returns ReviewCommentFieldsAuthorOrganization.Login, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorOrganization) GetLogin() string { return v.Login }
+
+// ReviewCommentFieldsAuthorUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type ReviewCommentFieldsAuthorUser struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns ReviewCommentFieldsAuthorUser.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorUser) GetTypename() string { return v.Typename }
+
+// GetLogin returns ReviewCommentFieldsAuthorUser.Login, and is useful for accessing the field via an interface.
+func (v *ReviewCommentFieldsAuthorUser) GetLogin() string { return v.Login }
+
+// ReviewRequestFields includes the GraphQL fields of ReviewRequest requested by the fragment ReviewRequestFields.
+// The GraphQL type's documentation follows.
+//
+// A request for a user to review a pull request.
+type ReviewRequestFields struct {
+	// The reviewer that is requested.
+	RequestedReviewer ReviewRequestFieldsRequestedReviewer `json:"-"`
 }
 
-// GetRequestedReviewer returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest.RequestedReviewer, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest) GetRequestedReviewer() getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer {
+// GetRequestedReviewer returns ReviewRequestFields.RequestedReviewer, and is useful for accessing the field via an interface.
+func (v *ReviewRequestFields) GetRequestedReviewer() ReviewRequestFieldsRequestedReviewer {
 	return v.RequestedReviewer
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest) UnmarshalJSON(b []byte) error {
+func (v *ReviewRequestFields) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest
+		*ReviewRequestFields
 		RequestedReviewer json.RawMessage `json:"requestedReviewer"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest = v
+	firstPass.ReviewRequestFields = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -810,22 +1546,22 @@ func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRe
 		dst := &v.RequestedReviewer
 		src := firstPass.RequestedReviewer
 		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer(
+			err = __unmarshalReviewRequestFieldsRequestedReviewer(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest.RequestedReviewer: %w", err)
+					"Unable to unmarshal ReviewRequestFields.RequestedReviewer: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest struct {
+type __premarshalReviewRequestFields struct {
 	RequestedReviewer json.RawMessage `json:"requestedReviewer"`
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest) MarshalJSON() ([]byte, error) {
+func (v *ReviewRequestFields) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -833,47 +1569,47 @@ func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRe
 	return json.Marshal(premarshaled)
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest) __premarshalJSON() (*__premarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest, error) {
-	var retval __premarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest
+func (v *ReviewRequestFields) __premarshalJSON() (*__premarshalReviewRequestFields, error) {
+	var retval __premarshalReviewRequestFields
 
 	{
 
 		dst := &retval.RequestedReviewer
 		src := v.RequestedReviewer
 		var err error
-		*dst, err = __marshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer(
+		*dst, err = __marshalReviewRequestFieldsRequestedReviewer(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"Unable to marshal getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequest.RequestedReviewer: %w", err)
+				"Unable to marshal ReviewRequestFields.RequestedReviewer: %w", err)
 		}
 	}
 	return &retval, nil
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer includes the requested fields of the GraphQL interface RequestedReviewer.
+// ReviewRequestFieldsRequestedReviewer includes the requested fields of the GraphQL interface RequestedReviewer.
 //
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer is implemented by the following types:
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerMannequin
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerTeam
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser
+// ReviewRequestFieldsRequestedReviewer is implemented by the following types:
+// ReviewRequestFieldsRequestedReviewerMannequin
+// ReviewRequestFieldsRequestedReviewerTeam
+// ReviewRequestFieldsRequestedReviewerUser
 // The GraphQL type's documentation follows.
 //
 // Types that can be requested reviewers.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer interface {
-	implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer()
+type ReviewRequestFieldsRequestedReviewer interface {
+	implementsGraphQLInterfaceReviewRequestFieldsRequestedReviewer()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() string
 }
 
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerMannequin) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer() {
+func (v *ReviewRequestFieldsRequestedReviewerMannequin) implementsGraphQLInterfaceReviewRequestFieldsRequestedReviewer() {
 }
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerTeam) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer() {
+func (v *ReviewRequestFieldsRequestedReviewerTeam) implementsGraphQLInterfaceReviewRequestFieldsRequestedReviewer() {
 }
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser) implementsGraphQLInterfacegetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer() {
+func (v *ReviewRequestFieldsRequestedReviewerUser) implementsGraphQLInterfaceReviewRequestFieldsRequestedReviewer() {
 }
 
-func __unmarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer(b []byte, v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer) error {
+func __unmarshalReviewRequestFieldsRequestedReviewer(b []byte, v *ReviewRequestFieldsRequestedReviewer) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -888,168 +1624,15609 @@ func __unmarshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNode
 
 	switch tn.TypeName {
 	case "Mannequin":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerMannequin)
+		*v = new(ReviewRequestFieldsRequestedReviewerMannequin)
 		return json.Unmarshal(b, *v)
 	case "Team":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerTeam)
+		*v = new(ReviewRequestFieldsRequestedReviewerTeam)
 		return json.Unmarshal(b, *v)
 	case "User":
-		*v = new(getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser)
+		*v = new(ReviewRequestFieldsRequestedReviewerUser)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing RequestedReviewer.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer: "%v"`, tn.TypeName)
+			`unexpected concrete type for ReviewRequestFieldsRequestedReviewer: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalgetRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer(v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer) ([]byte, error) {
+func __marshalReviewRequestFieldsRequestedReviewer(v *ReviewRequestFieldsRequestedReviewer) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerMannequin:
+	case *ReviewRequestFieldsRequestedReviewerMannequin:
 		typename = "Mannequin"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerMannequin
+			*ReviewRequestFieldsRequestedReviewerMannequin
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerTeam:
+	case *ReviewRequestFieldsRequestedReviewerTeam:
 		typename = "Team"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerTeam
+			*ReviewRequestFieldsRequestedReviewerTeam
 		}{typename, v}
 		return json.Marshal(result)
-	case *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser:
+	case *ReviewRequestFieldsRequestedReviewerUser:
 		typename = "User"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser
+			*ReviewRequestFieldsRequestedReviewerUser
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewer: "%T"`, v)
+			`unexpected concrete type for ReviewRequestFieldsRequestedReviewer: "%T"`, v)
 	}
 }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerMannequin includes the requested fields of the GraphQL type Mannequin.
+// ReviewRequestFieldsRequestedReviewerMannequin includes the requested fields of the GraphQL type Mannequin.
 // The GraphQL type's documentation follows.
 //
 // A placeholder user for attribution of imported data on GitHub.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerMannequin struct {
+type ReviewRequestFieldsRequestedReviewerMannequin struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerMannequin.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerMannequin) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns ReviewRequestFieldsRequestedReviewerMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewRequestFieldsRequestedReviewerMannequin) GetTypename() string { return v.Typename }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerTeam includes the requested fields of the GraphQL type Team.
+// ReviewRequestFieldsRequestedReviewerTeam includes the requested fields of the GraphQL type Team.
 // The GraphQL type's documentation follows.
 //
 // A team of users in an organization.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerTeam struct {
+type ReviewRequestFieldsRequestedReviewerTeam struct {
 	Typename string `json:"__typename"`
+	// The slug corresponding to the organization and team.
+	CombinedSlug string `json:"combinedSlug"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerTeam.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerTeam) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns ReviewRequestFieldsRequestedReviewerTeam.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewRequestFieldsRequestedReviewerTeam) GetTypename() string { return v.Typename }
+
+// GetCombinedSlug returns ReviewRequestFieldsRequestedReviewerTeam.CombinedSlug, and is useful for accessing the field via an interface.
+func (v *ReviewRequestFieldsRequestedReviewerTeam) GetCombinedSlug() string { return v.CombinedSlug }
 
-// getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser includes the requested fields of the GraphQL type User.
+// ReviewRequestFieldsRequestedReviewerUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
 // A user is an individual's account on GitHub that owns repositories and can make new content.
-type getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser struct {
+type ReviewRequestFieldsRequestedReviewerUser struct {
 	Typename string `json:"__typename"`
 	// The username used to login.
 	Login string `json:"login"`
 }
 
-// GetTypename returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser.Typename, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns ReviewRequestFieldsRequestedReviewerUser.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewRequestFieldsRequestedReviewerUser) GetTypename() string { return v.Typename }
 
-// GetLogin returns getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser.Login, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser) GetLogin() string {
-	return v.Login
-}
+// GetLogin returns ReviewRequestFieldsRequestedReviewerUser.Login, and is useful for accessing the field via an interface.
+func (v *ReviewRequestFieldsRequestedReviewerUser) GetLogin() string { return v.Login }
 
-// getRepositoryInfoResponse is returned by getRepositoryInfo on success.
-type getRepositoryInfoResponse struct {
-	// Lookup a given repository by the owner and repository name.
-	Repository *getRepositoryInfoRepository `json:"repository"`
+// ReviewThreadFields includes the GraphQL fields of PullRequestReviewThread requested by the fragment ReviewThreadFields.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type ReviewThreadFields struct {
+	Id string `json:"id"`
+	// Whether this thread has been resolved
+	IsResolved bool `json:"isResolved"`
+	// Indicates whether this thread was outdated by newer changes.
+	IsOutdated bool `json:"isOutdated"`
+	// Identifies the file path of this thread.
+	Path string `json:"path"`
+	// The line in the file to which this thread refers
+	Line int `json:"line"`
+	// The original line in the file to which this thread refers.
+	OriginalLine int `json:"originalLine"`
+	// A list of pull request comments associated with the thread.
+	Comments *ReviewThreadFieldsCommentsPullRequestReviewCommentConnection `json:"comments"`
 }
 
-// GetRepository returns getRepositoryInfoResponse.Repository, and is useful for accessing the field via an interface.
-func (v *getRepositoryInfoResponse) GetRepository() *getRepositoryInfoRepository { return v.Repository }
+// GetId returns ReviewThreadFields.Id, and is useful for accessing the field via an interface.
+func (v *ReviewThreadFields) GetId() string { return v.Id }
 
-func getRepositoryInfo(
+// GetIsResolved returns ReviewThreadFields.IsResolved, and is useful for accessing the field via an interface.
+func (v *ReviewThreadFields) GetIsResolved() bool { return v.IsResolved }
+
+// GetIsOutdated returns ReviewThreadFields.IsOutdated, and is useful for accessing the field via an interface.
+func (v *ReviewThreadFields) GetIsOutdated() bool { return v.IsOutdated }
+
+// GetPath returns ReviewThreadFields.Path, and is useful for accessing the field via an interface.
+func (v *ReviewThreadFields) GetPath() string { return v.Path }
+
+// GetLine returns ReviewThreadFields.Line, and is useful for accessing the field via an interface.
+func (v *ReviewThreadFields) GetLine() int { return v.Line }
+
+// GetOriginalLine returns ReviewThreadFields.OriginalLine, and is useful for accessing the field via an interface.
+func (v *ReviewThreadFields) GetOriginalLine() int { return v.OriginalLine }
+
+// GetComments returns ReviewThreadFields.Comments, and is useful for accessing the field via an interface.
+func (v *ReviewThreadFields) GetComments() *ReviewThreadFieldsCommentsPullRequestReviewCommentConnection {
+	return v.Comments
+}
+
+// ReviewThreadFieldsCommentsPullRequestReviewCommentConnection includes the requested fields of the GraphQL type PullRequestReviewCommentConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequestReviewComment.
+type ReviewThreadFieldsCommentsPullRequestReviewCommentConnection struct {
+	// A list of nodes.
+	Nodes []*ReviewCommentFields `json:"nodes"`
+}
+
+// GetNodes returns ReviewThreadFieldsCommentsPullRequestReviewCommentConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ReviewThreadFieldsCommentsPullRequestReviewCommentConnection) GetNodes() []*ReviewCommentFields {
+	return v.Nodes
+}
+
+// StatusContextFields includes the GraphQL fields of StatusContext requested by the fragment StatusContextFields.
+// The GraphQL type's documentation follows.
+//
+// Represents an individual commit status context
+type StatusContextFields struct {
+	// The name of this status context.
+	Context string `json:"context"`
+	// The state of this status context.
+	State StatusState `json:"state"`
+	// The description for this status context.
+	Description string `json:"description"`
+	// The URL for this status context.
+	TargetUrl string `json:"targetUrl"`
+}
+
+// GetContext returns StatusContextFields.Context, and is useful for accessing the field via an interface.
+func (v *StatusContextFields) GetContext() string { return v.Context }
+
+// GetState returns StatusContextFields.State, and is useful for accessing the field via an interface.
+func (v *StatusContextFields) GetState() StatusState { return v.State }
+
+// GetDescription returns StatusContextFields.Description, and is useful for accessing the field via an interface.
+func (v *StatusContextFields) GetDescription() string { return v.Description }
+
+// GetTargetUrl returns StatusContextFields.TargetUrl, and is useful for accessing the field via an interface.
+func (v *StatusContextFields) GetTargetUrl() string { return v.TargetUrl }
+
+// The possible commit status states.
+type StatusState string
+
+const (
+	// Status is errored.
+	StatusStateError StatusState = "ERROR"
+	// Status is expected.
+	StatusStateExpected StatusState = "EXPECTED"
+	// Status is failing.
+	StatusStateFailure StatusState = "FAILURE"
+	// Status is pending.
+	StatusStatePending StatusState = "PENDING"
+	// Status is successful.
+	StatusStateSuccess StatusState = "SUCCESS"
+)
+
+// __addPendingPullRequestReviewInput is used internally by genqlient
+type __addPendingPullRequestReviewInput struct {
+	PullRequestId string `json:"pullRequestId"`
+}
+
+// GetPullRequestId returns __addPendingPullRequestReviewInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__addPendingPullRequestReviewInput) GetPullRequestId() string { return v.PullRequestId }
+
+// __addPullRequestReviewInput is used internally by genqlient
+type __addPullRequestReviewInput struct {
+	PullRequestId string                 `json:"pullRequestId"`
+	Event         PullRequestReviewEvent `json:"event"`
+	Body          string                 `json:"body"`
+}
+
+// GetPullRequestId returns __addPullRequestReviewInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewInput) GetPullRequestId() string { return v.PullRequestId }
+
+// GetEvent returns __addPullRequestReviewInput.Event, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewInput) GetEvent() PullRequestReviewEvent { return v.Event }
+
+// GetBody returns __addPullRequestReviewInput.Body, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewInput) GetBody() string { return v.Body }
+
+// __addPullRequestReviewThreadInput is used internally by genqlient
+type __addPullRequestReviewThreadInput struct {
+	PullRequestReviewId string   `json:"pullRequestReviewId"`
+	Path                string   `json:"path"`
+	Line                int      `json:"line"`
+	Side                DiffSide `json:"side"`
+	Body                string   `json:"body"`
+}
+
+// GetPullRequestReviewId returns __addPullRequestReviewThreadInput.PullRequestReviewId, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewThreadInput) GetPullRequestReviewId() string {
+	return v.PullRequestReviewId
+}
+
+// GetPath returns __addPullRequestReviewThreadInput.Path, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewThreadInput) GetPath() string { return v.Path }
+
+// GetLine returns __addPullRequestReviewThreadInput.Line, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewThreadInput) GetLine() int { return v.Line }
+
+// GetSide returns __addPullRequestReviewThreadInput.Side, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewThreadInput) GetSide() DiffSide { return v.Side }
+
+// GetBody returns __addPullRequestReviewThreadInput.Body, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewThreadInput) GetBody() string { return v.Body }
+
+// __deletePullRequestReviewInput is used internally by genqlient
+type __deletePullRequestReviewInput struct {
+	PullRequestReviewId string `json:"pullRequestReviewId"`
+}
+
+// GetPullRequestReviewId returns __deletePullRequestReviewInput.PullRequestReviewId, and is useful for accessing the field via an interface.
+func (v *__deletePullRequestReviewInput) GetPullRequestReviewId() string {
+	return v.PullRequestReviewId
+}
+
+// __getPendingPullRequestReviewInput is used internally by genqlient
+type __getPendingPullRequestReviewInput struct {
+	PullRequestId string `json:"pullRequestId"`
+}
+
+// GetPullRequestId returns __getPendingPullRequestReviewInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__getPendingPullRequestReviewInput) GetPullRequestId() string { return v.PullRequestId }
+
+// __getPullRequestChecksInput is used internally by genqlient
+type __getPullRequestChecksInput struct {
+	Owner  string `json:"owner"`
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+}
+
+// GetOwner returns __getPullRequestChecksInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getPullRequestChecksInput) GetOwner() string { return v.Owner }
+
+// GetName returns __getPullRequestChecksInput.Name, and is useful for accessing the field via an interface.
+func (v *__getPullRequestChecksInput) GetName() string { return v.Name }
+
+// GetNumber returns __getPullRequestChecksInput.Number, and is useful for accessing the field via an interface.
+func (v *__getPullRequestChecksInput) GetNumber() int { return v.Number }
+
+// __getPullRequestDetailsInput is used internally by genqlient
+type __getPullRequestDetailsInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getPullRequestDetailsInput.Id, and is useful for accessing the field via an interface.
+func (v *__getPullRequestDetailsInput) GetId() string { return v.Id }
+
+// __getPullRequestLatestReviewsInput is used internally by genqlient
+type __getPullRequestLatestReviewsInput struct {
+	Owner  string `json:"owner"`
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+	After  string `json:"after,omitempty"`
+}
+
+// GetOwner returns __getPullRequestLatestReviewsInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getPullRequestLatestReviewsInput) GetOwner() string { return v.Owner }
+
+// GetName returns __getPullRequestLatestReviewsInput.Name, and is useful for accessing the field via an interface.
+func (v *__getPullRequestLatestReviewsInput) GetName() string { return v.Name }
+
+// GetNumber returns __getPullRequestLatestReviewsInput.Number, and is useful for accessing the field via an interface.
+func (v *__getPullRequestLatestReviewsInput) GetNumber() int { return v.Number }
+
+// GetAfter returns __getPullRequestLatestReviewsInput.After, and is useful for accessing the field via an interface.
+func (v *__getPullRequestLatestReviewsInput) GetAfter() string { return v.After }
+
+// __getPullRequestReviewRequestsInput is used internally by genqlient
+type __getPullRequestReviewRequestsInput struct {
+	Owner  string `json:"owner"`
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+	After  string `json:"after,omitempty"`
+}
+
+// GetOwner returns __getPullRequestReviewRequestsInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getPullRequestReviewRequestsInput) GetOwner() string { return v.Owner }
+
+// GetName returns __getPullRequestReviewRequestsInput.Name, and is useful for accessing the field via an interface.
+func (v *__getPullRequestReviewRequestsInput) GetName() string { return v.Name }
+
+// GetNumber returns __getPullRequestReviewRequestsInput.Number, and is useful for accessing the field via an interface.
+func (v *__getPullRequestReviewRequestsInput) GetNumber() int { return v.Number }
+
+// GetAfter returns __getPullRequestReviewRequestsInput.After, and is useful for accessing the field via an interface.
+func (v *__getPullRequestReviewRequestsInput) GetAfter() string { return v.After }
+
+// __getPullRequestReviewThreadsInput is used internally by genqlient
+type __getPullRequestReviewThreadsInput struct {
+	Owner  string `json:"owner"`
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+	After  string `json:"after,omitempty"`
+}
+
+// GetOwner returns __getPullRequestReviewThreadsInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getPullRequestReviewThreadsInput) GetOwner() string { return v.Owner }
+
+// GetName returns __getPullRequestReviewThreadsInput.Name, and is useful for accessing the field via an interface.
+func (v *__getPullRequestReviewThreadsInput) GetName() string { return v.Name }
+
+// GetNumber returns __getPullRequestReviewThreadsInput.Number, and is useful for accessing the field via an interface.
+func (v *__getPullRequestReviewThreadsInput) GetNumber() int { return v.Number }
+
+// GetAfter returns __getPullRequestReviewThreadsInput.After, and is useful for accessing the field via an interface.
+func (v *__getPullRequestReviewThreadsInput) GetAfter() string { return v.After }
+
+// __getRepositoryInfoInput is used internally by genqlient
+type __getRepositoryInfoInput struct {
+	Owner string `json:"owner"`
+	Name  string `json:"name"`
+	After string `json:"after,omitempty"`
+}
+
+// GetOwner returns __getRepositoryInfoInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getRepositoryInfoInput) GetOwner() string { return v.Owner }
+
+// GetName returns __getRepositoryInfoInput.Name, and is useful for accessing the field via an interface.
+func (v *__getRepositoryInfoInput) GetName() string { return v.Name }
+
+// GetAfter returns __getRepositoryInfoInput.After, and is useful for accessing the field via an interface.
+func (v *__getRepositoryInfoInput) GetAfter() string { return v.After }
+
+// __getViewerTeamsInput is used internally by genqlient
+type __getViewerTeamsInput struct {
+	Login string `json:"login"`
+	After string `json:"after,omitempty"`
+}
+
+// GetLogin returns __getViewerTeamsInput.Login, and is useful for accessing the field via an interface.
+func (v *__getViewerTeamsInput) GetLogin() string { return v.Login }
+
+// GetAfter returns __getViewerTeamsInput.After, and is useful for accessing the field via an interface.
+func (v *__getViewerTeamsInput) GetAfter() string { return v.After }
+
+// __replyToReviewCommentInput is used internally by genqlient
+type __replyToReviewCommentInput struct {
+	PullRequestId string `json:"pullRequestId"`
+	InReplyTo     string `json:"inReplyTo"`
+	Body          string `json:"body"`
+}
+
+// GetPullRequestId returns __replyToReviewCommentInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__replyToReviewCommentInput) GetPullRequestId() string { return v.PullRequestId }
+
+// GetInReplyTo returns __replyToReviewCommentInput.InReplyTo, and is useful for accessing the field via an interface.
+func (v *__replyToReviewCommentInput) GetInReplyTo() string { return v.InReplyTo }
+
+// GetBody returns __replyToReviewCommentInput.Body, and is useful for accessing the field via an interface.
+func (v *__replyToReviewCommentInput) GetBody() string { return v.Body }
+
+// __rerequestCheckSuiteInput is used internally by genqlient
+type __rerequestCheckSuiteInput struct {
+	RepositoryId string `json:"repositoryId"`
+	CheckSuiteId string `json:"checkSuiteId"`
+}
+
+// GetRepositoryId returns __rerequestCheckSuiteInput.RepositoryId, and is useful for accessing the field via an interface.
+func (v *__rerequestCheckSuiteInput) GetRepositoryId() string { return v.RepositoryId }
+
+// GetCheckSuiteId returns __rerequestCheckSuiteInput.CheckSuiteId, and is useful for accessing the field via an interface.
+func (v *__rerequestCheckSuiteInput) GetCheckSuiteId() string { return v.CheckSuiteId }
+
+// __resolveReviewThreadInput is used internally by genqlient
+type __resolveReviewThreadInput struct {
+	ThreadId string `json:"threadId"`
+}
+
+// GetThreadId returns __resolveReviewThreadInput.ThreadId, and is useful for accessing the field via an interface.
+func (v *__resolveReviewThreadInput) GetThreadId() string { return v.ThreadId }
+
+// __searchPullRequestsInput is used internally by genqlient
+type __searchPullRequestsInput struct {
+	Query string `json:"query"`
+	After string `json:"after,omitempty"`
+}
+
+// GetQuery returns __searchPullRequestsInput.Query, and is useful for accessing the field via an interface.
+func (v *__searchPullRequestsInput) GetQuery() string { return v.Query }
+
+// GetAfter returns __searchPullRequestsInput.After, and is useful for accessing the field via an interface.
+func (v *__searchPullRequestsInput) GetAfter() string { return v.After }
+
+// __submitPullRequestReviewInput is used internally by genqlient
+type __submitPullRequestReviewInput struct {
+	PullRequestReviewId string                 `json:"pullRequestReviewId"`
+	Event               PullRequestReviewEvent `json:"event"`
+	Body                string                 `json:"body"`
+}
+
+// GetPullRequestReviewId returns __submitPullRequestReviewInput.PullRequestReviewId, and is useful for accessing the field via an interface.
+func (v *__submitPullRequestReviewInput) GetPullRequestReviewId() string {
+	return v.PullRequestReviewId
+}
+
+// GetEvent returns __submitPullRequestReviewInput.Event, and is useful for accessing the field via an interface.
+func (v *__submitPullRequestReviewInput) GetEvent() PullRequestReviewEvent { return v.Event }
+
+// GetBody returns __submitPullRequestReviewInput.Body, and is useful for accessing the field via an interface.
+func (v *__submitPullRequestReviewInput) GetBody() string { return v.Body }
+
+// __unresolveReviewThreadInput is used internally by genqlient
+type __unresolveReviewThreadInput struct {
+	ThreadId string `json:"threadId"`
+}
+
+// GetThreadId returns __unresolveReviewThreadInput.ThreadId, and is useful for accessing the field via an interface.
+func (v *__unresolveReviewThreadInput) GetThreadId() string { return v.ThreadId }
+
+// addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload includes the requested fields of the GraphQL type AddPullRequestReviewPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of AddPullRequestReview
+type addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload struct {
+	// The newly created pull request review.
+	PullRequestReview *addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview `json:"pullRequestReview"`
+}
+
+// GetPullRequestReview returns addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload.PullRequestReview, and is useful for accessing the field via an interface.
+func (v *addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload) GetPullRequestReview() *addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview {
+	return v.PullRequestReview
+}
+
+// addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// The GraphQL type's documentation follows.
+//
+// A review object for a given pull request.
+type addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview struct {
+	Id string `json:"id"`
+}
+
+// GetId returns addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview.Id, and is useful for accessing the field via an interface.
+func (v *addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview) GetId() string {
+	return v.Id
+}
+
+// addPendingPullRequestReviewResponse is returned by addPendingPullRequestReview on success.
+type addPendingPullRequestReviewResponse struct {
+	// Adds a review to a Pull Request.
+	AddPullRequestReview *addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload `json:"addPullRequestReview"`
+}
+
+// GetAddPullRequestReview returns addPendingPullRequestReviewResponse.AddPullRequestReview, and is useful for accessing the field via an interface.
+func (v *addPendingPullRequestReviewResponse) GetAddPullRequestReview() *addPendingPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload {
+	return v.AddPullRequestReview
+}
+
+// addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload includes the requested fields of the GraphQL type AddPullRequestReviewPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of AddPullRequestReview
+type addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload struct {
+	// The newly created pull request review.
+	PullRequestReview *PullRequestReviewFields `json:"pullRequestReview"`
+}
+
+// GetPullRequestReview returns addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload.PullRequestReview, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload) GetPullRequestReview() *PullRequestReviewFields {
+	return v.PullRequestReview
+}
+
+// addPullRequestReviewResponse is returned by addPullRequestReview on success.
+type addPullRequestReviewResponse struct {
+	// Adds a review to a Pull Request.
+	AddPullRequestReview *addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload `json:"addPullRequestReview"`
+}
+
+// GetAddPullRequestReview returns addPullRequestReviewResponse.AddPullRequestReview, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewResponse) GetAddPullRequestReview() *addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload {
+	return v.AddPullRequestReview
+}
+
+// addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayload includes the requested fields of the GraphQL type AddPullRequestReviewThreadPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of AddPullRequestReviewThread
+type addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayload struct {
+	// The newly created thread.
+	Thread *addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayloadThreadPullRequestReviewThread `json:"thread"`
+}
+
+// GetThread returns addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayload.Thread, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayload) GetThread() *addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayloadThreadPullRequestReviewThread {
+	return v.Thread
+}
+
+// addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayloadThreadPullRequestReviewThread includes the requested fields of the GraphQL type PullRequestReviewThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayloadThreadPullRequestReviewThread struct {
+	Id string `json:"id"`
+}
+
+// GetId returns addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayloadThreadPullRequestReviewThread.Id, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayloadThreadPullRequestReviewThread) GetId() string {
+	return v.Id
+}
+
+// addPullRequestReviewThreadResponse is returned by addPullRequestReviewThread on success.
+type addPullRequestReviewThreadResponse struct {
+	// Adds a new thread to a pending Pull Request Review.
+	AddPullRequestReviewThread *addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayload `json:"addPullRequestReviewThread"`
+}
+
+// GetAddPullRequestReviewThread returns addPullRequestReviewThreadResponse.AddPullRequestReviewThread, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewThreadResponse) GetAddPullRequestReviewThread() *addPullRequestReviewThreadAddPullRequestReviewThreadAddPullRequestReviewThreadPayload {
+	return v.AddPullRequestReviewThread
+}
+
+// deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayload includes the requested fields of the GraphQL type DeletePullRequestReviewPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeletePullRequestReview
+type deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayload struct {
+	// The deleted pull request review.
+	PullRequestReview *deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayloadPullRequestReview `json:"pullRequestReview"`
+}
+
+// GetPullRequestReview returns deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayload.PullRequestReview, and is useful for accessing the field via an interface.
+func (v *deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayload) GetPullRequestReview() *deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayloadPullRequestReview {
+	return v.PullRequestReview
+}
+
+// deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayloadPullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// The GraphQL type's documentation follows.
+//
+// A review object for a given pull request.
+type deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayloadPullRequestReview struct {
+	Id string `json:"id"`
+}
+
+// GetId returns deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayloadPullRequestReview.Id, and is useful for accessing the field via an interface.
+func (v *deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayloadPullRequestReview) GetId() string {
+	return v.Id
+}
+
+// deletePullRequestReviewResponse is returned by deletePullRequestReview on success.
+type deletePullRequestReviewResponse struct {
+	// Deletes a pull request review.
+	DeletePullRequestReview *deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayload `json:"deletePullRequestReview"`
+}
+
+// GetDeletePullRequestReview returns deletePullRequestReviewResponse.DeletePullRequestReview, and is useful for accessing the field via an interface.
+func (v *deletePullRequestReviewResponse) GetDeletePullRequestReview() *deletePullRequestReviewDeletePullRequestReviewDeletePullRequestReviewPayload {
+	return v.DeletePullRequestReview
+}
+
+// getPendingPullRequestReviewNode includes the requested fields of the GraphQL interface Node.
+//
+// getPendingPullRequestReviewNode is implemented by the following types:
+// getPendingPullRequestReviewNodeAddedToProjectEvent
+// getPendingPullRequestReviewNodeApp
+// getPendingPullRequestReviewNodeAssignedEvent
+// getPendingPullRequestReviewNodeAutoMergeDisabledEvent
+// getPendingPullRequestReviewNodeAutoMergeEnabledEvent
+// getPendingPullRequestReviewNodeAutoRebaseEnabledEvent
+// getPendingPullRequestReviewNodeAutoSquashEnabledEvent
+// getPendingPullRequestReviewNodeAutomaticBaseChangeFailedEvent
+// getPendingPullRequestReviewNodeAutomaticBaseChangeSucceededEvent
+// getPendingPullRequestReviewNodeBaseRefChangedEvent
+// getPendingPullRequestReviewNodeBaseRefDeletedEvent
+// getPendingPullRequestReviewNodeBaseRefForcePushedEvent
+// getPendingPullRequestReviewNodeBlob
+// getPendingPullRequestReviewNodeBot
+// getPendingPullRequestReviewNodeBranchProtectionRule
+// getPendingPullRequestReviewNodeBypassForcePushAllowance
+// getPendingPullRequestReviewNodeBypassPullRequestAllowance
+// getPendingPullRequestReviewNodeCWE
+// getPendingPullRequestReviewNodeCheckRun
+// getPendingPullRequestReviewNodeCheckSuite
+// getPendingPullRequestReviewNodeClosedEvent
+// getPendingPullRequestReviewNodeCodeOfConduct
+// getPendingPullRequestReviewNodeCommentDeletedEvent
+// getPendingPullRequestReviewNodeCommit
+// getPendingPullRequestReviewNodeCommitComment
+// getPendingPullRequestReviewNodeCommitCommentThread
+// getPendingPullRequestReviewNodeComparison
+// getPendingPullRequestReviewNodeConnectedEvent
+// getPendingPullRequestReviewNodeConvertToDraftEvent
+// getPendingPullRequestReviewNodeConvertedNoteToIssueEvent
+// getPendingPullRequestReviewNodeConvertedToDiscussionEvent
+// getPendingPullRequestReviewNodeCrossReferencedEvent
+// getPendingPullRequestReviewNodeDemilestonedEvent
+// getPendingPullRequestReviewNodeDependencyGraphManifest
+// getPendingPullRequestReviewNodeDeployKey
+// getPendingPullRequestReviewNodeDeployedEvent
+// getPendingPullRequestReviewNodeDeployment
+// getPendingPullRequestReviewNodeDeploymentEnvironmentChangedEvent
+// getPendingPullRequestReviewNodeDeploymentReview
+// getPendingPullRequestReviewNodeDeploymentStatus
+// getPendingPullRequestReviewNodeDisconnectedEvent
+// getPendingPullRequestReviewNodeDiscussion
+// getPendingPullRequestReviewNodeDiscussionCategory
+// getPendingPullRequestReviewNodeDiscussionComment
+// getPendingPullRequestReviewNodeDiscussionPoll
+// getPendingPullRequestReviewNodeDiscussionPollOption
+// getPendingPullRequestReviewNodeDraftIssue
+// getPendingPullRequestReviewNodeEnterprise
+// getPendingPullRequestReviewNodeEnterpriseAdministratorInvitation
+// getPendingPullRequestReviewNodeEnterpriseIdentityProvider
+// getPendingPullRequestReviewNodeEnterpriseRepositoryInfo
+// getPendingPullRequestReviewNodeEnterpriseServerInstallation
+// getPendingPullRequestReviewNodeEnterpriseServerUserAccount
+// getPendingPullRequestReviewNodeEnterpriseServerUserAccountEmail
+// getPendingPullRequestReviewNodeEnterpriseServerUserAccountsUpload
+// getPendingPullRequestReviewNodeEnterpriseUserAccount
+// getPendingPullRequestReviewNodeEnvironment
+// getPendingPullRequestReviewNodeExternalIdentity
+// getPendingPullRequestReviewNodeGist
+// getPendingPullRequestReviewNodeGistComment
+// getPendingPullRequestReviewNodeHeadRefDeletedEvent
+// getPendingPullRequestReviewNodeHeadRefForcePushedEvent
+// getPendingPullRequestReviewNodeHeadRefRestoredEvent
+// getPendingPullRequestReviewNodeIpAllowListEntry
+// getPendingPullRequestReviewNodeIssue
+// getPendingPullRequestReviewNodeIssueComment
+// getPendingPullRequestReviewNodeLabel
+// getPendingPullRequestReviewNodeLabeledEvent
+// getPendingPullRequestReviewNodeLanguage
+// getPendingPullRequestReviewNodeLicense
+// getPendingPullRequestReviewNodeLinkedBranch
+// getPendingPullRequestReviewNodeLockedEvent
+// getPendingPullRequestReviewNodeMannequin
+// getPendingPullRequestReviewNodeMarkedAsDuplicateEvent
+// getPendingPullRequestReviewNodeMarketplaceCategory
+// getPendingPullRequestReviewNodeMarketplaceListing
+// getPendingPullRequestReviewNodeMembersCanDeleteReposClearAuditEntry
+// getPendingPullRequestReviewNodeMembersCanDeleteReposDisableAuditEntry
+// getPendingPullRequestReviewNodeMembersCanDeleteReposEnableAuditEntry
+// getPendingPullRequestReviewNodeMentionedEvent
+// getPendingPullRequestReviewNodeMergedEvent
+// getPendingPullRequestReviewNodeMigrationSource
+// getPendingPullRequestReviewNodeMilestone
+// getPendingPullRequestReviewNodeMilestonedEvent
+// getPendingPullRequestReviewNodeMovedColumnsInProjectEvent
+// getPendingPullRequestReviewNodeOIDCProvider
+// getPendingPullRequestReviewNodeOauthApplicationCreateAuditEntry
+// getPendingPullRequestReviewNodeOrgAddBillingManagerAuditEntry
+// getPendingPullRequestReviewNodeOrgAddMemberAuditEntry
+// getPendingPullRequestReviewNodeOrgBlockUserAuditEntry
+// getPendingPullRequestReviewNodeOrgConfigDisableCollaboratorsOnlyAuditEntry
+// getPendingPullRequestReviewNodeOrgConfigEnableCollaboratorsOnlyAuditEntry
+// getPendingPullRequestReviewNodeOrgCreateAuditEntry
+// getPendingPullRequestReviewNodeOrgDisableOauthAppRestrictionsAuditEntry
+// getPendingPullRequestReviewNodeOrgDisableSamlAuditEntry
+// getPendingPullRequestReviewNodeOrgDisableTwoFactorRequirementAuditEntry
+// getPendingPullRequestReviewNodeOrgEnableOauthAppRestrictionsAuditEntry
+// getPendingPullRequestReviewNodeOrgEnableSamlAuditEntry
+// getPendingPullRequestReviewNodeOrgEnableTwoFactorRequirementAuditEntry
+// getPendingPullRequestReviewNodeOrgInviteMemberAuditEntry
+// getPendingPullRequestReviewNodeOrgInviteToBusinessAuditEntry
+// getPendingPullRequestReviewNodeOrgOauthAppAccessApprovedAuditEntry
+// getPendingPullRequestReviewNodeOrgOauthAppAccessDeniedAuditEntry
+// getPendingPullRequestReviewNodeOrgOauthAppAccessRequestedAuditEntry
+// getPendingPullRequestReviewNodeOrgRemoveBillingManagerAuditEntry
+// getPendingPullRequestReviewNodeOrgRemoveMemberAuditEntry
+// getPendingPullRequestReviewNodeOrgRemoveOutsideCollaboratorAuditEntry
+// getPendingPullRequestReviewNodeOrgRestoreMemberAuditEntry
+// getPendingPullRequestReviewNodeOrgUnblockUserAuditEntry
+// getPendingPullRequestReviewNodeOrgUpdateDefaultRepositoryPermissionAuditEntry
+// getPendingPullRequestReviewNodeOrgUpdateMemberAuditEntry
+// getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry
+// getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry
+// getPendingPullRequestReviewNodeOrganization
+// getPendingPullRequestReviewNodeOrganizationIdentityProvider
+// getPendingPullRequestReviewNodeOrganizationInvitation
+// getPendingPullRequestReviewNodeOrganizationMigration
+// getPendingPullRequestReviewNodePackage
+// getPendingPullRequestReviewNodePackageFile
+// getPendingPullRequestReviewNodePackageTag
+// getPendingPullRequestReviewNodePackageVersion
+// getPendingPullRequestReviewNodePinnedDiscussion
+// getPendingPullRequestReviewNodePinnedEvent
+// getPendingPullRequestReviewNodePinnedIssue
+// getPendingPullRequestReviewNodePrivateRepositoryForkingDisableAuditEntry
+// getPendingPullRequestReviewNodePrivateRepositoryForkingEnableAuditEntry
+// getPendingPullRequestReviewNodeProject
+// getPendingPullRequestReviewNodeProjectCard
+// getPendingPullRequestReviewNodeProjectColumn
+// getPendingPullRequestReviewNodeProjectV2
+// getPendingPullRequestReviewNodeProjectV2Field
+// getPendingPullRequestReviewNodeProjectV2Item
+// getPendingPullRequestReviewNodeProjectV2ItemFieldDateValue
+// getPendingPullRequestReviewNodeProjectV2ItemFieldIterationValue
+// getPendingPullRequestReviewNodeProjectV2ItemFieldNumberValue
+// getPendingPullRequestReviewNodeProjectV2ItemFieldSingleSelectValue
+// getPendingPullRequestReviewNodeProjectV2ItemFieldTextValue
+// getPendingPullRequestReviewNodeProjectV2IterationField
+// getPendingPullRequestReviewNodeProjectV2SingleSelectField
+// getPendingPullRequestReviewNodeProjectV2View
+// getPendingPullRequestReviewNodeProjectV2Workflow
+// getPendingPullRequestReviewNodePublicKey
+// getPendingPullRequestReviewNodePullRequest
+// getPendingPullRequestReviewNodePullRequestCommit
+// getPendingPullRequestReviewNodePullRequestCommitCommentThread
+// getPendingPullRequestReviewNodePullRequestReview
+// getPendingPullRequestReviewNodePullRequestReviewComment
+// getPendingPullRequestReviewNodePullRequestReviewThread
+// getPendingPullRequestReviewNodePullRequestThread
+// getPendingPullRequestReviewNodePush
+// getPendingPullRequestReviewNodePushAllowance
+// getPendingPullRequestReviewNodeReaction
+// getPendingPullRequestReviewNodeReadyForReviewEvent
+// getPendingPullRequestReviewNodeRef
+// getPendingPullRequestReviewNodeReferencedEvent
+// getPendingPullRequestReviewNodeRelease
+// getPendingPullRequestReviewNodeReleaseAsset
+// getPendingPullRequestReviewNodeRemovedFromProjectEvent
+// getPendingPullRequestReviewNodeRenamedTitleEvent
+// getPendingPullRequestReviewNodeReopenedEvent
+// getPendingPullRequestReviewNodeRepoAccessAuditEntry
+// getPendingPullRequestReviewNodeRepoAddMemberAuditEntry
+// getPendingPullRequestReviewNodeRepoAddTopicAuditEntry
+// getPendingPullRequestReviewNodeRepoArchivedAuditEntry
+// getPendingPullRequestReviewNodeRepoChangeMergeSettingAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigDisableAnonymousGitAccessAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigDisableCollaboratorsOnlyAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigDisableContributorsOnlyAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigDisableSockpuppetDisallowedAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigEnableAnonymousGitAccessAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigEnableCollaboratorsOnlyAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigEnableContributorsOnlyAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigEnableSockpuppetDisallowedAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigLockAnonymousGitAccessAuditEntry
+// getPendingPullRequestReviewNodeRepoConfigUnlockAnonymousGitAccessAuditEntry
+// getPendingPullRequestReviewNodeRepoCreateAuditEntry
+// getPendingPullRequestReviewNodeRepoDestroyAuditEntry
+// getPendingPullRequestReviewNodeRepoRemoveMemberAuditEntry
+// getPendingPullRequestReviewNodeRepoRemoveTopicAuditEntry
+// getPendingPullRequestReviewNodeRepository
+// getPendingPullRequestReviewNodeRepositoryInvitation
+// getPendingPullRequestReviewNodeRepositoryMigration
+// getPendingPullRequestReviewNodeRepositoryTopic
+// getPendingPullRequestReviewNodeRepositoryVisibilityChangeDisableAuditEntry
+// getPendingPullRequestReviewNodeRepositoryVisibilityChangeEnableAuditEntry
+// getPendingPullRequestReviewNodeRepositoryVulnerabilityAlert
+// getPendingPullRequestReviewNodeReviewDismissalAllowance
+// getPendingPullRequestReviewNodeReviewDismissedEvent
+// getPendingPullRequestReviewNodeReviewRequest
+// getPendingPullRequestReviewNodeReviewRequestRemovedEvent
+// getPendingPullRequestReviewNodeReviewRequestedEvent
+// getPendingPullRequestReviewNodeSavedReply
+// getPendingPullRequestReviewNodeSecurityAdvisory
+// getPendingPullRequestReviewNodeSponsorsActivity
+// getPendingPullRequestReviewNodeSponsorsListing
+// getPendingPullRequestReviewNodeSponsorsListingFeaturedItem
+// getPendingPullRequestReviewNodeSponsorsTier
+// getPendingPullRequestReviewNodeSponsorship
+// getPendingPullRequestReviewNodeSponsorshipNewsletter
+// getPendingPullRequestReviewNodeStatus
+// getPendingPullRequestReviewNodeStatusCheckRollup
+// getPendingPullRequestReviewNodeStatusContext
+// getPendingPullRequestReviewNodeSubscribedEvent
+// getPendingPullRequestReviewNodeTag
+// getPendingPullRequestReviewNodeTeam
+// getPendingPullRequestReviewNodeTeamAddMemberAuditEntry
+// getPendingPullRequestReviewNodeTeamAddRepositoryAuditEntry
+// getPendingPullRequestReviewNodeTeamChangeParentTeamAuditEntry
+// getPendingPullRequestReviewNodeTeamDiscussion
+// getPendingPullRequestReviewNodeTeamDiscussionComment
+// getPendingPullRequestReviewNodeTeamRemoveMemberAuditEntry
+// getPendingPullRequestReviewNodeTeamRemoveRepositoryAuditEntry
+// getPendingPullRequestReviewNodeTopic
+// getPendingPullRequestReviewNodeTransferredEvent
+// getPendingPullRequestReviewNodeTree
+// getPendingPullRequestReviewNodeUnassignedEvent
+// getPendingPullRequestReviewNodeUnlabeledEvent
+// getPendingPullRequestReviewNodeUnlockedEvent
+// getPendingPullRequestReviewNodeUnmarkedAsDuplicateEvent
+// getPendingPullRequestReviewNodeUnpinnedEvent
+// getPendingPullRequestReviewNodeUnsubscribedEvent
+// getPendingPullRequestReviewNodeUser
+// getPendingPullRequestReviewNodeUserBlockedEvent
+// getPendingPullRequestReviewNodeUserContentEdit
+// getPendingPullRequestReviewNodeUserStatus
+// getPendingPullRequestReviewNodeVerifiableDomain
+// getPendingPullRequestReviewNodeWorkflow
+// getPendingPullRequestReviewNodeWorkflowRun
+// The GraphQL type's documentation follows.
+//
+// An object with an ID.
+type getPendingPullRequestReviewNode interface {
+	implementsGraphQLInterfacegetPendingPullRequestReviewNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getPendingPullRequestReviewNodeAddedToProjectEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeApp) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeAssignedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeAutoMergeDisabledEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeAutoMergeEnabledEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeAutoRebaseEnabledEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeAutoSquashEnabledEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeAutomaticBaseChangeFailedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeAutomaticBaseChangeSucceededEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeBaseRefChangedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeBaseRefDeletedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeBaseRefForcePushedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeBlob) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeBot) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeBranchProtectionRule) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeBypassForcePushAllowance) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeBypassPullRequestAllowance) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeCWE) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeCheckRun) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeCheckSuite) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeClosedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeCodeOfConduct) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeCommentDeletedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeCommit) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeCommitComment) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeCommitCommentThread) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeComparison) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeConnectedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeConvertToDraftEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeConvertedNoteToIssueEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeConvertedToDiscussionEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeCrossReferencedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDemilestonedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDependencyGraphManifest) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDeployKey) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDeployedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDeployment) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDeploymentEnvironmentChangedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDeploymentReview) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDeploymentStatus) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDisconnectedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDiscussion) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDiscussionCategory) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDiscussionComment) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDiscussionPoll) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDiscussionPollOption) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeDraftIssue) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnterprise) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnterpriseAdministratorInvitation) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnterpriseIdentityProvider) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnterpriseRepositoryInfo) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnterpriseServerInstallation) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnterpriseServerUserAccount) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnterpriseServerUserAccountEmail) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnterpriseServerUserAccountsUpload) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnterpriseUserAccount) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeEnvironment) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeExternalIdentity) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeGist) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeGistComment) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeHeadRefDeletedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeHeadRefForcePushedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeHeadRefRestoredEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeIpAllowListEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeIssue) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeIssueComment) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeLabel) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeLabeledEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeLanguage) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeLicense) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeLinkedBranch) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeLockedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMannequin) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMarkedAsDuplicateEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMarketplaceCategory) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMarketplaceListing) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMembersCanDeleteReposClearAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMembersCanDeleteReposDisableAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMembersCanDeleteReposEnableAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMentionedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMergedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMigrationSource) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMilestone) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMilestonedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeMovedColumnsInProjectEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOIDCProvider) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOauthApplicationCreateAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgAddBillingManagerAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgAddMemberAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgBlockUserAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgConfigDisableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgConfigEnableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgCreateAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgDisableOauthAppRestrictionsAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgDisableSamlAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgDisableTwoFactorRequirementAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgEnableOauthAppRestrictionsAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgEnableSamlAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgEnableTwoFactorRequirementAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgInviteMemberAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgInviteToBusinessAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgOauthAppAccessApprovedAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgOauthAppAccessDeniedAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgOauthAppAccessRequestedAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgRemoveBillingManagerAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgRemoveMemberAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgRemoveOutsideCollaboratorAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgRestoreMemberAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgUnblockUserAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgUpdateDefaultRepositoryPermissionAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgUpdateMemberAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrganization) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrganizationIdentityProvider) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrganizationInvitation) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeOrganizationMigration) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePackage) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePackageFile) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePackageTag) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePackageVersion) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePinnedDiscussion) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePinnedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePinnedIssue) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePrivateRepositoryForkingDisableAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePrivateRepositoryForkingEnableAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProject) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectCard) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectColumn) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2Field) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2Item) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldDateValue) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldIterationValue) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldNumberValue) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldSingleSelectValue) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldTextValue) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2IterationField) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2SingleSelectField) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2View) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeProjectV2Workflow) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePublicKey) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePullRequest) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePullRequestCommit) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePullRequestCommitCommentThread) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePullRequestReview) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePullRequestReviewComment) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePullRequestReviewThread) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePullRequestThread) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePush) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodePushAllowance) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReaction) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReadyForReviewEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRef) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReferencedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRelease) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReleaseAsset) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRemovedFromProjectEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRenamedTitleEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReopenedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoAccessAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoAddMemberAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoAddTopicAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoArchivedAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoChangeMergeSettingAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigDisableAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigDisableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigDisableContributorsOnlyAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigDisableSockpuppetDisallowedAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigEnableAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigEnableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigEnableContributorsOnlyAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigEnableSockpuppetDisallowedAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigLockAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoConfigUnlockAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoCreateAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoDestroyAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoRemoveMemberAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepoRemoveTopicAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepository) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepositoryInvitation) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepositoryMigration) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepositoryTopic) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepositoryVisibilityChangeDisableAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepositoryVisibilityChangeEnableAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeRepositoryVulnerabilityAlert) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReviewDismissalAllowance) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReviewDismissedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReviewRequest) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReviewRequestRemovedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeReviewRequestedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeSavedReply) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeSecurityAdvisory) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeSponsorsActivity) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeSponsorsListing) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeSponsorsListingFeaturedItem) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeSponsorsTier) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeSponsorship) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeSponsorshipNewsletter) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeStatus) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeStatusCheckRollup) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeStatusContext) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeSubscribedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTag) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTeam) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTeamAddMemberAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTeamAddRepositoryAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTeamChangeParentTeamAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTeamDiscussion) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTeamDiscussionComment) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTeamRemoveMemberAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTeamRemoveRepositoryAuditEntry) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTopic) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTransferredEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeTree) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUnassignedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUnlabeledEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUnlockedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUnmarkedAsDuplicateEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUnpinnedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUnsubscribedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUser) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUserBlockedEvent) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUserContentEdit) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeUserStatus) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeVerifiableDomain) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeWorkflow) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+func (v *getPendingPullRequestReviewNodeWorkflowRun) implementsGraphQLInterfacegetPendingPullRequestReviewNode() {
+}
+
+func __unmarshalgetPendingPullRequestReviewNode(b []byte, v *getPendingPullRequestReviewNode) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AddedToProjectEvent":
+		*v = new(getPendingPullRequestReviewNodeAddedToProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "App":
+		*v = new(getPendingPullRequestReviewNodeApp)
+		return json.Unmarshal(b, *v)
+	case "AssignedEvent":
+		*v = new(getPendingPullRequestReviewNodeAssignedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeDisabledEvent":
+		*v = new(getPendingPullRequestReviewNodeAutoMergeDisabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeEnabledEvent":
+		*v = new(getPendingPullRequestReviewNodeAutoMergeEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoRebaseEnabledEvent":
+		*v = new(getPendingPullRequestReviewNodeAutoRebaseEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoSquashEnabledEvent":
+		*v = new(getPendingPullRequestReviewNodeAutoSquashEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeFailedEvent":
+		*v = new(getPendingPullRequestReviewNodeAutomaticBaseChangeFailedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeSucceededEvent":
+		*v = new(getPendingPullRequestReviewNodeAutomaticBaseChangeSucceededEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefChangedEvent":
+		*v = new(getPendingPullRequestReviewNodeBaseRefChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefDeletedEvent":
+		*v = new(getPendingPullRequestReviewNodeBaseRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefForcePushedEvent":
+		*v = new(getPendingPullRequestReviewNodeBaseRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "Blob":
+		*v = new(getPendingPullRequestReviewNodeBlob)
+		return json.Unmarshal(b, *v)
+	case "Bot":
+		*v = new(getPendingPullRequestReviewNodeBot)
+		return json.Unmarshal(b, *v)
+	case "BranchProtectionRule":
+		*v = new(getPendingPullRequestReviewNodeBranchProtectionRule)
+		return json.Unmarshal(b, *v)
+	case "BypassForcePushAllowance":
+		*v = new(getPendingPullRequestReviewNodeBypassForcePushAllowance)
+		return json.Unmarshal(b, *v)
+	case "BypassPullRequestAllowance":
+		*v = new(getPendingPullRequestReviewNodeBypassPullRequestAllowance)
+		return json.Unmarshal(b, *v)
+	case "CWE":
+		*v = new(getPendingPullRequestReviewNodeCWE)
+		return json.Unmarshal(b, *v)
+	case "CheckRun":
+		*v = new(getPendingPullRequestReviewNodeCheckRun)
+		return json.Unmarshal(b, *v)
+	case "CheckSuite":
+		*v = new(getPendingPullRequestReviewNodeCheckSuite)
+		return json.Unmarshal(b, *v)
+	case "ClosedEvent":
+		*v = new(getPendingPullRequestReviewNodeClosedEvent)
+		return json.Unmarshal(b, *v)
+	case "CodeOfConduct":
+		*v = new(getPendingPullRequestReviewNodeCodeOfConduct)
+		return json.Unmarshal(b, *v)
+	case "CommentDeletedEvent":
+		*v = new(getPendingPullRequestReviewNodeCommentDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getPendingPullRequestReviewNodeCommit)
+		return json.Unmarshal(b, *v)
+	case "CommitComment":
+		*v = new(getPendingPullRequestReviewNodeCommitComment)
+		return json.Unmarshal(b, *v)
+	case "CommitCommentThread":
+		*v = new(getPendingPullRequestReviewNodeCommitCommentThread)
+		return json.Unmarshal(b, *v)
+	case "Comparison":
+		*v = new(getPendingPullRequestReviewNodeComparison)
+		return json.Unmarshal(b, *v)
+	case "ConnectedEvent":
+		*v = new(getPendingPullRequestReviewNodeConnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertToDraftEvent":
+		*v = new(getPendingPullRequestReviewNodeConvertToDraftEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedNoteToIssueEvent":
+		*v = new(getPendingPullRequestReviewNodeConvertedNoteToIssueEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedToDiscussionEvent":
+		*v = new(getPendingPullRequestReviewNodeConvertedToDiscussionEvent)
+		return json.Unmarshal(b, *v)
+	case "CrossReferencedEvent":
+		*v = new(getPendingPullRequestReviewNodeCrossReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "DemilestonedEvent":
+		*v = new(getPendingPullRequestReviewNodeDemilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "DependencyGraphManifest":
+		*v = new(getPendingPullRequestReviewNodeDependencyGraphManifest)
+		return json.Unmarshal(b, *v)
+	case "DeployKey":
+		*v = new(getPendingPullRequestReviewNodeDeployKey)
+		return json.Unmarshal(b, *v)
+	case "DeployedEvent":
+		*v = new(getPendingPullRequestReviewNodeDeployedEvent)
+		return json.Unmarshal(b, *v)
+	case "Deployment":
+		*v = new(getPendingPullRequestReviewNodeDeployment)
+		return json.Unmarshal(b, *v)
+	case "DeploymentEnvironmentChangedEvent":
+		*v = new(getPendingPullRequestReviewNodeDeploymentEnvironmentChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "DeploymentReview":
+		*v = new(getPendingPullRequestReviewNodeDeploymentReview)
+		return json.Unmarshal(b, *v)
+	case "DeploymentStatus":
+		*v = new(getPendingPullRequestReviewNodeDeploymentStatus)
+		return json.Unmarshal(b, *v)
+	case "DisconnectedEvent":
+		*v = new(getPendingPullRequestReviewNodeDisconnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "Discussion":
+		*v = new(getPendingPullRequestReviewNodeDiscussion)
+		return json.Unmarshal(b, *v)
+	case "DiscussionCategory":
+		*v = new(getPendingPullRequestReviewNodeDiscussionCategory)
+		return json.Unmarshal(b, *v)
+	case "DiscussionComment":
+		*v = new(getPendingPullRequestReviewNodeDiscussionComment)
+		return json.Unmarshal(b, *v)
+	case "DiscussionPoll":
+		*v = new(getPendingPullRequestReviewNodeDiscussionPoll)
+		return json.Unmarshal(b, *v)
+	case "DiscussionPollOption":
+		*v = new(getPendingPullRequestReviewNodeDiscussionPollOption)
+		return json.Unmarshal(b, *v)
+	case "DraftIssue":
+		*v = new(getPendingPullRequestReviewNodeDraftIssue)
+		return json.Unmarshal(b, *v)
+	case "Enterprise":
+		*v = new(getPendingPullRequestReviewNodeEnterprise)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseAdministratorInvitation":
+		*v = new(getPendingPullRequestReviewNodeEnterpriseAdministratorInvitation)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseIdentityProvider":
+		*v = new(getPendingPullRequestReviewNodeEnterpriseIdentityProvider)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseRepositoryInfo":
+		*v = new(getPendingPullRequestReviewNodeEnterpriseRepositoryInfo)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerInstallation":
+		*v = new(getPendingPullRequestReviewNodeEnterpriseServerInstallation)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccount":
+		*v = new(getPendingPullRequestReviewNodeEnterpriseServerUserAccount)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccountEmail":
+		*v = new(getPendingPullRequestReviewNodeEnterpriseServerUserAccountEmail)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccountsUpload":
+		*v = new(getPendingPullRequestReviewNodeEnterpriseServerUserAccountsUpload)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseUserAccount":
+		*v = new(getPendingPullRequestReviewNodeEnterpriseUserAccount)
+		return json.Unmarshal(b, *v)
+	case "Environment":
+		*v = new(getPendingPullRequestReviewNodeEnvironment)
+		return json.Unmarshal(b, *v)
+	case "ExternalIdentity":
+		*v = new(getPendingPullRequestReviewNodeExternalIdentity)
+		return json.Unmarshal(b, *v)
+	case "Gist":
+		*v = new(getPendingPullRequestReviewNodeGist)
+		return json.Unmarshal(b, *v)
+	case "GistComment":
+		*v = new(getPendingPullRequestReviewNodeGistComment)
+		return json.Unmarshal(b, *v)
+	case "HeadRefDeletedEvent":
+		*v = new(getPendingPullRequestReviewNodeHeadRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefForcePushedEvent":
+		*v = new(getPendingPullRequestReviewNodeHeadRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefRestoredEvent":
+		*v = new(getPendingPullRequestReviewNodeHeadRefRestoredEvent)
+		return json.Unmarshal(b, *v)
+	case "IpAllowListEntry":
+		*v = new(getPendingPullRequestReviewNodeIpAllowListEntry)
+		return json.Unmarshal(b, *v)
+	case "Issue":
+		*v = new(getPendingPullRequestReviewNodeIssue)
+		return json.Unmarshal(b, *v)
+	case "IssueComment":
+		*v = new(getPendingPullRequestReviewNodeIssueComment)
+		return json.Unmarshal(b, *v)
+	case "Label":
+		*v = new(getPendingPullRequestReviewNodeLabel)
+		return json.Unmarshal(b, *v)
+	case "LabeledEvent":
+		*v = new(getPendingPullRequestReviewNodeLabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "Language":
+		*v = new(getPendingPullRequestReviewNodeLanguage)
+		return json.Unmarshal(b, *v)
+	case "License":
+		*v = new(getPendingPullRequestReviewNodeLicense)
+		return json.Unmarshal(b, *v)
+	case "LinkedBranch":
+		*v = new(getPendingPullRequestReviewNodeLinkedBranch)
+		return json.Unmarshal(b, *v)
+	case "LockedEvent":
+		*v = new(getPendingPullRequestReviewNodeLockedEvent)
+		return json.Unmarshal(b, *v)
+	case "Mannequin":
+		*v = new(getPendingPullRequestReviewNodeMannequin)
+		return json.Unmarshal(b, *v)
+	case "MarkedAsDuplicateEvent":
+		*v = new(getPendingPullRequestReviewNodeMarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceCategory":
+		*v = new(getPendingPullRequestReviewNodeMarketplaceCategory)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceListing":
+		*v = new(getPendingPullRequestReviewNodeMarketplaceListing)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposClearAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeMembersCanDeleteReposClearAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposDisableAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeMembersCanDeleteReposDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposEnableAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeMembersCanDeleteReposEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MentionedEvent":
+		*v = new(getPendingPullRequestReviewNodeMentionedEvent)
+		return json.Unmarshal(b, *v)
+	case "MergedEvent":
+		*v = new(getPendingPullRequestReviewNodeMergedEvent)
+		return json.Unmarshal(b, *v)
+	case "MigrationSource":
+		*v = new(getPendingPullRequestReviewNodeMigrationSource)
+		return json.Unmarshal(b, *v)
+	case "Milestone":
+		*v = new(getPendingPullRequestReviewNodeMilestone)
+		return json.Unmarshal(b, *v)
+	case "MilestonedEvent":
+		*v = new(getPendingPullRequestReviewNodeMilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "MovedColumnsInProjectEvent":
+		*v = new(getPendingPullRequestReviewNodeMovedColumnsInProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "OIDCProvider":
+		*v = new(getPendingPullRequestReviewNodeOIDCProvider)
+		return json.Unmarshal(b, *v)
+	case "OauthApplicationCreateAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOauthApplicationCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgAddBillingManagerAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgAddBillingManagerAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgAddMemberAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgBlockUserAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgBlockUserAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgConfigDisableCollaboratorsOnlyAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgConfigDisableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgConfigEnableCollaboratorsOnlyAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgConfigEnableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgCreateAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableOauthAppRestrictionsAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgDisableOauthAppRestrictionsAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableSamlAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgDisableSamlAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableTwoFactorRequirementAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgDisableTwoFactorRequirementAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableOauthAppRestrictionsAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgEnableOauthAppRestrictionsAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableSamlAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgEnableSamlAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableTwoFactorRequirementAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgEnableTwoFactorRequirementAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgInviteMemberAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgInviteMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgInviteToBusinessAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgInviteToBusinessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessApprovedAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgOauthAppAccessApprovedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessDeniedAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgOauthAppAccessDeniedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessRequestedAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgOauthAppAccessRequestedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveBillingManagerAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgRemoveBillingManagerAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveMemberAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveOutsideCollaboratorAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgRemoveOutsideCollaboratorAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRestoreMemberAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgRestoreMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUnblockUserAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgUnblockUserAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateDefaultRepositoryPermissionAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgUpdateDefaultRepositoryPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgUpdateMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberRepositoryCreationPermissionAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberRepositoryInvitationPermissionAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(getPendingPullRequestReviewNodeOrganization)
+		return json.Unmarshal(b, *v)
+	case "OrganizationIdentityProvider":
+		*v = new(getPendingPullRequestReviewNodeOrganizationIdentityProvider)
+		return json.Unmarshal(b, *v)
+	case "OrganizationInvitation":
+		*v = new(getPendingPullRequestReviewNodeOrganizationInvitation)
+		return json.Unmarshal(b, *v)
+	case "OrganizationMigration":
+		*v = new(getPendingPullRequestReviewNodeOrganizationMigration)
+		return json.Unmarshal(b, *v)
+	case "Package":
+		*v = new(getPendingPullRequestReviewNodePackage)
+		return json.Unmarshal(b, *v)
+	case "PackageFile":
+		*v = new(getPendingPullRequestReviewNodePackageFile)
+		return json.Unmarshal(b, *v)
+	case "PackageTag":
+		*v = new(getPendingPullRequestReviewNodePackageTag)
+		return json.Unmarshal(b, *v)
+	case "PackageVersion":
+		*v = new(getPendingPullRequestReviewNodePackageVersion)
+		return json.Unmarshal(b, *v)
+	case "PinnedDiscussion":
+		*v = new(getPendingPullRequestReviewNodePinnedDiscussion)
+		return json.Unmarshal(b, *v)
+	case "PinnedEvent":
+		*v = new(getPendingPullRequestReviewNodePinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "PinnedIssue":
+		*v = new(getPendingPullRequestReviewNodePinnedIssue)
+		return json.Unmarshal(b, *v)
+	case "PrivateRepositoryForkingDisableAuditEntry":
+		*v = new(getPendingPullRequestReviewNodePrivateRepositoryForkingDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "PrivateRepositoryForkingEnableAuditEntry":
+		*v = new(getPendingPullRequestReviewNodePrivateRepositoryForkingEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Project":
+		*v = new(getPendingPullRequestReviewNodeProject)
+		return json.Unmarshal(b, *v)
+	case "ProjectCard":
+		*v = new(getPendingPullRequestReviewNodeProjectCard)
+		return json.Unmarshal(b, *v)
+	case "ProjectColumn":
+		*v = new(getPendingPullRequestReviewNodeProjectColumn)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2":
+		*v = new(getPendingPullRequestReviewNodeProjectV2)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Field":
+		*v = new(getPendingPullRequestReviewNodeProjectV2Field)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Item":
+		*v = new(getPendingPullRequestReviewNodeProjectV2Item)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldDateValue":
+		*v = new(getPendingPullRequestReviewNodeProjectV2ItemFieldDateValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldIterationValue":
+		*v = new(getPendingPullRequestReviewNodeProjectV2ItemFieldIterationValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldNumberValue":
+		*v = new(getPendingPullRequestReviewNodeProjectV2ItemFieldNumberValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldSingleSelectValue":
+		*v = new(getPendingPullRequestReviewNodeProjectV2ItemFieldSingleSelectValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldTextValue":
+		*v = new(getPendingPullRequestReviewNodeProjectV2ItemFieldTextValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2IterationField":
+		*v = new(getPendingPullRequestReviewNodeProjectV2IterationField)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2SingleSelectField":
+		*v = new(getPendingPullRequestReviewNodeProjectV2SingleSelectField)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2View":
+		*v = new(getPendingPullRequestReviewNodeProjectV2View)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Workflow":
+		*v = new(getPendingPullRequestReviewNodeProjectV2Workflow)
+		return json.Unmarshal(b, *v)
+	case "PublicKey":
+		*v = new(getPendingPullRequestReviewNodePublicKey)
+		return json.Unmarshal(b, *v)
+	case "PullRequest":
+		*v = new(getPendingPullRequestReviewNodePullRequest)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommit":
+		*v = new(getPendingPullRequestReviewNodePullRequestCommit)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommitCommentThread":
+		*v = new(getPendingPullRequestReviewNodePullRequestCommitCommentThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReview":
+		*v = new(getPendingPullRequestReviewNodePullRequestReview)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReviewComment":
+		*v = new(getPendingPullRequestReviewNodePullRequestReviewComment)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReviewThread":
+		*v = new(getPendingPullRequestReviewNodePullRequestReviewThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestThread":
+		*v = new(getPendingPullRequestReviewNodePullRequestThread)
+		return json.Unmarshal(b, *v)
+	case "Push":
+		*v = new(getPendingPullRequestReviewNodePush)
+		return json.Unmarshal(b, *v)
+	case "PushAllowance":
+		*v = new(getPendingPullRequestReviewNodePushAllowance)
+		return json.Unmarshal(b, *v)
+	case "Reaction":
+		*v = new(getPendingPullRequestReviewNodeReaction)
+		return json.Unmarshal(b, *v)
+	case "ReadyForReviewEvent":
+		*v = new(getPendingPullRequestReviewNodeReadyForReviewEvent)
+		return json.Unmarshal(b, *v)
+	case "Ref":
+		*v = new(getPendingPullRequestReviewNodeRef)
+		return json.Unmarshal(b, *v)
+	case "ReferencedEvent":
+		*v = new(getPendingPullRequestReviewNodeReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "Release":
+		*v = new(getPendingPullRequestReviewNodeRelease)
+		return json.Unmarshal(b, *v)
+	case "ReleaseAsset":
+		*v = new(getPendingPullRequestReviewNodeReleaseAsset)
+		return json.Unmarshal(b, *v)
+	case "RemovedFromProjectEvent":
+		*v = new(getPendingPullRequestReviewNodeRemovedFromProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "RenamedTitleEvent":
+		*v = new(getPendingPullRequestReviewNodeRenamedTitleEvent)
+		return json.Unmarshal(b, *v)
+	case "ReopenedEvent":
+		*v = new(getPendingPullRequestReviewNodeReopenedEvent)
+		return json.Unmarshal(b, *v)
+	case "RepoAccessAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoAddMemberAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoAddTopicAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoAddTopicAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoArchivedAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoArchivedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoChangeMergeSettingAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoChangeMergeSettingAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableAnonymousGitAccessAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigDisableAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableCollaboratorsOnlyAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigDisableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableContributorsOnlyAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigDisableContributorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableSockpuppetDisallowedAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigDisableSockpuppetDisallowedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableAnonymousGitAccessAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigEnableAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableCollaboratorsOnlyAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigEnableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableContributorsOnlyAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigEnableContributorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableSockpuppetDisallowedAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigEnableSockpuppetDisallowedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigLockAnonymousGitAccessAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigLockAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigUnlockAnonymousGitAccessAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoConfigUnlockAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoCreateAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoDestroyAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoDestroyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoRemoveMemberAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoRemoveTopicAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepoRemoveTopicAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Repository":
+		*v = new(getPendingPullRequestReviewNodeRepository)
+		return json.Unmarshal(b, *v)
+	case "RepositoryInvitation":
+		*v = new(getPendingPullRequestReviewNodeRepositoryInvitation)
+		return json.Unmarshal(b, *v)
+	case "RepositoryMigration":
+		*v = new(getPendingPullRequestReviewNodeRepositoryMigration)
+		return json.Unmarshal(b, *v)
+	case "RepositoryTopic":
+		*v = new(getPendingPullRequestReviewNodeRepositoryTopic)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVisibilityChangeDisableAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepositoryVisibilityChangeDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVisibilityChangeEnableAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeRepositoryVisibilityChangeEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVulnerabilityAlert":
+		*v = new(getPendingPullRequestReviewNodeRepositoryVulnerabilityAlert)
+		return json.Unmarshal(b, *v)
+	case "ReviewDismissalAllowance":
+		*v = new(getPendingPullRequestReviewNodeReviewDismissalAllowance)
+		return json.Unmarshal(b, *v)
+	case "ReviewDismissedEvent":
+		*v = new(getPendingPullRequestReviewNodeReviewDismissedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequest":
+		*v = new(getPendingPullRequestReviewNodeReviewRequest)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestRemovedEvent":
+		*v = new(getPendingPullRequestReviewNodeReviewRequestRemovedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestedEvent":
+		*v = new(getPendingPullRequestReviewNodeReviewRequestedEvent)
+		return json.Unmarshal(b, *v)
+	case "SavedReply":
+		*v = new(getPendingPullRequestReviewNodeSavedReply)
+		return json.Unmarshal(b, *v)
+	case "SecurityAdvisory":
+		*v = new(getPendingPullRequestReviewNodeSecurityAdvisory)
+		return json.Unmarshal(b, *v)
+	case "SponsorsActivity":
+		*v = new(getPendingPullRequestReviewNodeSponsorsActivity)
+		return json.Unmarshal(b, *v)
+	case "SponsorsListing":
+		*v = new(getPendingPullRequestReviewNodeSponsorsListing)
+		return json.Unmarshal(b, *v)
+	case "SponsorsListingFeaturedItem":
+		*v = new(getPendingPullRequestReviewNodeSponsorsListingFeaturedItem)
+		return json.Unmarshal(b, *v)
+	case "SponsorsTier":
+		*v = new(getPendingPullRequestReviewNodeSponsorsTier)
+		return json.Unmarshal(b, *v)
+	case "Sponsorship":
+		*v = new(getPendingPullRequestReviewNodeSponsorship)
+		return json.Unmarshal(b, *v)
+	case "SponsorshipNewsletter":
+		*v = new(getPendingPullRequestReviewNodeSponsorshipNewsletter)
+		return json.Unmarshal(b, *v)
+	case "Status":
+		*v = new(getPendingPullRequestReviewNodeStatus)
+		return json.Unmarshal(b, *v)
+	case "StatusCheckRollup":
+		*v = new(getPendingPullRequestReviewNodeStatusCheckRollup)
+		return json.Unmarshal(b, *v)
+	case "StatusContext":
+		*v = new(getPendingPullRequestReviewNodeStatusContext)
+		return json.Unmarshal(b, *v)
+	case "SubscribedEvent":
+		*v = new(getPendingPullRequestReviewNodeSubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getPendingPullRequestReviewNodeTag)
+		return json.Unmarshal(b, *v)
+	case "Team":
+		*v = new(getPendingPullRequestReviewNodeTeam)
+		return json.Unmarshal(b, *v)
+	case "TeamAddMemberAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeTeamAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamAddRepositoryAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeTeamAddRepositoryAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamChangeParentTeamAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeTeamChangeParentTeamAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamDiscussion":
+		*v = new(getPendingPullRequestReviewNodeTeamDiscussion)
+		return json.Unmarshal(b, *v)
+	case "TeamDiscussionComment":
+		*v = new(getPendingPullRequestReviewNodeTeamDiscussionComment)
+		return json.Unmarshal(b, *v)
+	case "TeamRemoveMemberAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeTeamRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamRemoveRepositoryAuditEntry":
+		*v = new(getPendingPullRequestReviewNodeTeamRemoveRepositoryAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(getPendingPullRequestReviewNodeTopic)
+		return json.Unmarshal(b, *v)
+	case "TransferredEvent":
+		*v = new(getPendingPullRequestReviewNodeTransferredEvent)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getPendingPullRequestReviewNodeTree)
+		return json.Unmarshal(b, *v)
+	case "UnassignedEvent":
+		*v = new(getPendingPullRequestReviewNodeUnassignedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlabeledEvent":
+		*v = new(getPendingPullRequestReviewNodeUnlabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlockedEvent":
+		*v = new(getPendingPullRequestReviewNodeUnlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnmarkedAsDuplicateEvent":
+		*v = new(getPendingPullRequestReviewNodeUnmarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "UnpinnedEvent":
+		*v = new(getPendingPullRequestReviewNodeUnpinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnsubscribedEvent":
+		*v = new(getPendingPullRequestReviewNodeUnsubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(getPendingPullRequestReviewNodeUser)
+		return json.Unmarshal(b, *v)
+	case "UserBlockedEvent":
+		*v = new(getPendingPullRequestReviewNodeUserBlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UserContentEdit":
+		*v = new(getPendingPullRequestReviewNodeUserContentEdit)
+		return json.Unmarshal(b, *v)
+	case "UserStatus":
+		*v = new(getPendingPullRequestReviewNodeUserStatus)
+		return json.Unmarshal(b, *v)
+	case "VerifiableDomain":
+		*v = new(getPendingPullRequestReviewNodeVerifiableDomain)
+		return json.Unmarshal(b, *v)
+	case "Workflow":
+		*v = new(getPendingPullRequestReviewNodeWorkflow)
+		return json.Unmarshal(b, *v)
+	case "WorkflowRun":
+		*v = new(getPendingPullRequestReviewNodeWorkflowRun)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Node.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getPendingPullRequestReviewNode: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetPendingPullRequestReviewNode(v *getPendingPullRequestReviewNode) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getPendingPullRequestReviewNodeAddedToProjectEvent:
+		typename = "AddedToProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeAddedToProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeApp:
+		typename = "App"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeAssignedEvent:
+		typename = "AssignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeAssignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeAutoMergeDisabledEvent:
+		typename = "AutoMergeDisabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeAutoMergeDisabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeAutoMergeEnabledEvent:
+		typename = "AutoMergeEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeAutoMergeEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeAutoRebaseEnabledEvent:
+		typename = "AutoRebaseEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeAutoRebaseEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeAutoSquashEnabledEvent:
+		typename = "AutoSquashEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeAutoSquashEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeAutomaticBaseChangeFailedEvent:
+		typename = "AutomaticBaseChangeFailedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeAutomaticBaseChangeFailedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeAutomaticBaseChangeSucceededEvent:
+		typename = "AutomaticBaseChangeSucceededEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeAutomaticBaseChangeSucceededEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeBaseRefChangedEvent:
+		typename = "BaseRefChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeBaseRefChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeBaseRefDeletedEvent:
+		typename = "BaseRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeBaseRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeBaseRefForcePushedEvent:
+		typename = "BaseRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeBaseRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeBot:
+		typename = "Bot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeBot
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeBranchProtectionRule:
+		typename = "BranchProtectionRule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeBranchProtectionRule
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeBypassForcePushAllowance:
+		typename = "BypassForcePushAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeBypassForcePushAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeBypassPullRequestAllowance:
+		typename = "BypassPullRequestAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeBypassPullRequestAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeCWE:
+		typename = "CWE"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeCWE
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeCheckRun:
+		typename = "CheckRun"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeCheckRun
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeCheckSuite:
+		typename = "CheckSuite"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeCheckSuite
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeClosedEvent:
+		typename = "ClosedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeClosedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeCodeOfConduct:
+		typename = "CodeOfConduct"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeCodeOfConduct
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeCommentDeletedEvent:
+		typename = "CommentDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeCommentDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeCommitComment:
+		typename = "CommitComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeCommitComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeCommitCommentThread:
+		typename = "CommitCommentThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeCommitCommentThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeComparison:
+		typename = "Comparison"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeComparison
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeConnectedEvent:
+		typename = "ConnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeConnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeConvertToDraftEvent:
+		typename = "ConvertToDraftEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeConvertToDraftEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeConvertedNoteToIssueEvent:
+		typename = "ConvertedNoteToIssueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeConvertedNoteToIssueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeConvertedToDiscussionEvent:
+		typename = "ConvertedToDiscussionEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeConvertedToDiscussionEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeCrossReferencedEvent:
+		typename = "CrossReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeCrossReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDemilestonedEvent:
+		typename = "DemilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDemilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDependencyGraphManifest:
+		typename = "DependencyGraphManifest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDependencyGraphManifest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDeployKey:
+		typename = "DeployKey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDeployKey
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDeployedEvent:
+		typename = "DeployedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDeployedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDeployment:
+		typename = "Deployment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDeployment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDeploymentEnvironmentChangedEvent:
+		typename = "DeploymentEnvironmentChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDeploymentEnvironmentChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDeploymentReview:
+		typename = "DeploymentReview"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDeploymentReview
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDeploymentStatus:
+		typename = "DeploymentStatus"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDeploymentStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDisconnectedEvent:
+		typename = "DisconnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDisconnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDiscussion:
+		typename = "Discussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDiscussionCategory:
+		typename = "DiscussionCategory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDiscussionCategory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDiscussionComment:
+		typename = "DiscussionComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDiscussionComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDiscussionPoll:
+		typename = "DiscussionPoll"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDiscussionPoll
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDiscussionPollOption:
+		typename = "DiscussionPollOption"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDiscussionPollOption
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeDraftIssue:
+		typename = "DraftIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeDraftIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnterprise:
+		typename = "Enterprise"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnterprise
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnterpriseAdministratorInvitation:
+		typename = "EnterpriseAdministratorInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnterpriseAdministratorInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnterpriseIdentityProvider:
+		typename = "EnterpriseIdentityProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnterpriseIdentityProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnterpriseRepositoryInfo:
+		typename = "EnterpriseRepositoryInfo"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnterpriseRepositoryInfo
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnterpriseServerInstallation:
+		typename = "EnterpriseServerInstallation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnterpriseServerInstallation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnterpriseServerUserAccount:
+		typename = "EnterpriseServerUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnterpriseServerUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnterpriseServerUserAccountEmail:
+		typename = "EnterpriseServerUserAccountEmail"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnterpriseServerUserAccountEmail
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnterpriseServerUserAccountsUpload:
+		typename = "EnterpriseServerUserAccountsUpload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnterpriseServerUserAccountsUpload
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnterpriseUserAccount:
+		typename = "EnterpriseUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnterpriseUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeEnvironment:
+		typename = "Environment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeEnvironment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeExternalIdentity:
+		typename = "ExternalIdentity"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeExternalIdentity
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeGist:
+		typename = "Gist"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeGist
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeGistComment:
+		typename = "GistComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeGistComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeHeadRefDeletedEvent:
+		typename = "HeadRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeHeadRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeHeadRefForcePushedEvent:
+		typename = "HeadRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeHeadRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeHeadRefRestoredEvent:
+		typename = "HeadRefRestoredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeHeadRefRestoredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeIpAllowListEntry:
+		typename = "IpAllowListEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeIpAllowListEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeIssue:
+		typename = "Issue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeIssueComment:
+		typename = "IssueComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeIssueComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeLabel:
+		typename = "Label"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeLabel
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeLabeledEvent:
+		typename = "LabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeLabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeLanguage:
+		typename = "Language"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeLanguage
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeLicense:
+		typename = "License"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeLicense
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeLinkedBranch:
+		typename = "LinkedBranch"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeLinkedBranch
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeLockedEvent:
+		typename = "LockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeLockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMannequin:
+		typename = "Mannequin"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMannequin
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMarkedAsDuplicateEvent:
+		typename = "MarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMarketplaceCategory:
+		typename = "MarketplaceCategory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMarketplaceCategory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMarketplaceListing:
+		typename = "MarketplaceListing"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMarketplaceListing
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMembersCanDeleteReposClearAuditEntry:
+		typename = "MembersCanDeleteReposClearAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMembersCanDeleteReposClearAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMembersCanDeleteReposDisableAuditEntry:
+		typename = "MembersCanDeleteReposDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMembersCanDeleteReposDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMembersCanDeleteReposEnableAuditEntry:
+		typename = "MembersCanDeleteReposEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMembersCanDeleteReposEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMentionedEvent:
+		typename = "MentionedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMentionedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMergedEvent:
+		typename = "MergedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMergedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMigrationSource:
+		typename = "MigrationSource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMigrationSource
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMilestone:
+		typename = "Milestone"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMilestone
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMilestonedEvent:
+		typename = "MilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeMovedColumnsInProjectEvent:
+		typename = "MovedColumnsInProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeMovedColumnsInProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOIDCProvider:
+		typename = "OIDCProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOIDCProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOauthApplicationCreateAuditEntry:
+		typename = "OauthApplicationCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOauthApplicationCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgAddBillingManagerAuditEntry:
+		typename = "OrgAddBillingManagerAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgAddBillingManagerAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgAddMemberAuditEntry:
+		typename = "OrgAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgBlockUserAuditEntry:
+		typename = "OrgBlockUserAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgBlockUserAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgConfigDisableCollaboratorsOnlyAuditEntry:
+		typename = "OrgConfigDisableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgConfigDisableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgConfigEnableCollaboratorsOnlyAuditEntry:
+		typename = "OrgConfigEnableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgConfigEnableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgCreateAuditEntry:
+		typename = "OrgCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgDisableOauthAppRestrictionsAuditEntry:
+		typename = "OrgDisableOauthAppRestrictionsAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgDisableOauthAppRestrictionsAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgDisableSamlAuditEntry:
+		typename = "OrgDisableSamlAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgDisableSamlAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgDisableTwoFactorRequirementAuditEntry:
+		typename = "OrgDisableTwoFactorRequirementAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgDisableTwoFactorRequirementAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgEnableOauthAppRestrictionsAuditEntry:
+		typename = "OrgEnableOauthAppRestrictionsAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgEnableOauthAppRestrictionsAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgEnableSamlAuditEntry:
+		typename = "OrgEnableSamlAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgEnableSamlAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgEnableTwoFactorRequirementAuditEntry:
+		typename = "OrgEnableTwoFactorRequirementAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgEnableTwoFactorRequirementAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgInviteMemberAuditEntry:
+		typename = "OrgInviteMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgInviteMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgInviteToBusinessAuditEntry:
+		typename = "OrgInviteToBusinessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgInviteToBusinessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgOauthAppAccessApprovedAuditEntry:
+		typename = "OrgOauthAppAccessApprovedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgOauthAppAccessApprovedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgOauthAppAccessDeniedAuditEntry:
+		typename = "OrgOauthAppAccessDeniedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgOauthAppAccessDeniedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgOauthAppAccessRequestedAuditEntry:
+		typename = "OrgOauthAppAccessRequestedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgOauthAppAccessRequestedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgRemoveBillingManagerAuditEntry:
+		typename = "OrgRemoveBillingManagerAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgRemoveBillingManagerAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgRemoveMemberAuditEntry:
+		typename = "OrgRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgRemoveOutsideCollaboratorAuditEntry:
+		typename = "OrgRemoveOutsideCollaboratorAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgRemoveOutsideCollaboratorAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgRestoreMemberAuditEntry:
+		typename = "OrgRestoreMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgRestoreMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgUnblockUserAuditEntry:
+		typename = "OrgUnblockUserAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgUnblockUserAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgUpdateDefaultRepositoryPermissionAuditEntry:
+		typename = "OrgUpdateDefaultRepositoryPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgUpdateDefaultRepositoryPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgUpdateMemberAuditEntry:
+		typename = "OrgUpdateMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgUpdateMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry:
+		typename = "OrgUpdateMemberRepositoryCreationPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry:
+		typename = "OrgUpdateMemberRepositoryInvitationPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrganizationIdentityProvider:
+		typename = "OrganizationIdentityProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrganizationIdentityProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrganizationInvitation:
+		typename = "OrganizationInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrganizationInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeOrganizationMigration:
+		typename = "OrganizationMigration"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeOrganizationMigration
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePackage:
+		typename = "Package"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePackage
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePackageFile:
+		typename = "PackageFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePackageFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePackageTag:
+		typename = "PackageTag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePackageTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePackageVersion:
+		typename = "PackageVersion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePackageVersion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePinnedDiscussion:
+		typename = "PinnedDiscussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePinnedDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePinnedEvent:
+		typename = "PinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePinnedIssue:
+		typename = "PinnedIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePinnedIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePrivateRepositoryForkingDisableAuditEntry:
+		typename = "PrivateRepositoryForkingDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePrivateRepositoryForkingDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePrivateRepositoryForkingEnableAuditEntry:
+		typename = "PrivateRepositoryForkingEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePrivateRepositoryForkingEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProject:
+		typename = "Project"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProject
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectCard:
+		typename = "ProjectCard"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectCard
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectColumn:
+		typename = "ProjectColumn"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectColumn
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2:
+		typename = "ProjectV2"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2Field:
+		typename = "ProjectV2Field"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2Field
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2Item:
+		typename = "ProjectV2Item"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2Item
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2ItemFieldDateValue:
+		typename = "ProjectV2ItemFieldDateValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2ItemFieldDateValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2ItemFieldIterationValue:
+		typename = "ProjectV2ItemFieldIterationValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2ItemFieldIterationValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2ItemFieldNumberValue:
+		typename = "ProjectV2ItemFieldNumberValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2ItemFieldNumberValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2ItemFieldSingleSelectValue:
+		typename = "ProjectV2ItemFieldSingleSelectValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2ItemFieldSingleSelectValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2ItemFieldTextValue:
+		typename = "ProjectV2ItemFieldTextValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2ItemFieldTextValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2IterationField:
+		typename = "ProjectV2IterationField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2IterationField
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2SingleSelectField:
+		typename = "ProjectV2SingleSelectField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2SingleSelectField
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2View:
+		typename = "ProjectV2View"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2View
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeProjectV2Workflow:
+		typename = "ProjectV2Workflow"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeProjectV2Workflow
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePublicKey:
+		typename = "PublicKey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePublicKey
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePullRequest:
+		typename = "PullRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePullRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePullRequestCommit:
+		typename = "PullRequestCommit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePullRequestCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePullRequestCommitCommentThread:
+		typename = "PullRequestCommitCommentThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePullRequestCommitCommentThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePullRequestReview:
+		typename = "PullRequestReview"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePullRequestReview
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePullRequestReviewComment:
+		typename = "PullRequestReviewComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePullRequestReviewComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePullRequestReviewThread:
+		typename = "PullRequestReviewThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePullRequestReviewThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePullRequestThread:
+		typename = "PullRequestThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePullRequestThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePush:
+		typename = "Push"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePush
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodePushAllowance:
+		typename = "PushAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodePushAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReaction:
+		typename = "Reaction"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReaction
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReadyForReviewEvent:
+		typename = "ReadyForReviewEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReadyForReviewEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRef:
+		typename = "Ref"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRef
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReferencedEvent:
+		typename = "ReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRelease:
+		typename = "Release"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRelease
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReleaseAsset:
+		typename = "ReleaseAsset"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReleaseAsset
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRemovedFromProjectEvent:
+		typename = "RemovedFromProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRemovedFromProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRenamedTitleEvent:
+		typename = "RenamedTitleEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRenamedTitleEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReopenedEvent:
+		typename = "ReopenedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReopenedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoAccessAuditEntry:
+		typename = "RepoAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoAddMemberAuditEntry:
+		typename = "RepoAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoAddTopicAuditEntry:
+		typename = "RepoAddTopicAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoAddTopicAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoArchivedAuditEntry:
+		typename = "RepoArchivedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoArchivedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoChangeMergeSettingAuditEntry:
+		typename = "RepoChangeMergeSettingAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoChangeMergeSettingAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigDisableAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigDisableAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigDisableAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigDisableCollaboratorsOnlyAuditEntry:
+		typename = "RepoConfigDisableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigDisableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigDisableContributorsOnlyAuditEntry:
+		typename = "RepoConfigDisableContributorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigDisableContributorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigDisableSockpuppetDisallowedAuditEntry:
+		typename = "RepoConfigDisableSockpuppetDisallowedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigDisableSockpuppetDisallowedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigEnableAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigEnableAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigEnableAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigEnableCollaboratorsOnlyAuditEntry:
+		typename = "RepoConfigEnableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigEnableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigEnableContributorsOnlyAuditEntry:
+		typename = "RepoConfigEnableContributorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigEnableContributorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigEnableSockpuppetDisallowedAuditEntry:
+		typename = "RepoConfigEnableSockpuppetDisallowedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigEnableSockpuppetDisallowedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigLockAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigLockAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigLockAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoConfigUnlockAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigUnlockAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoConfigUnlockAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoCreateAuditEntry:
+		typename = "RepoCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoDestroyAuditEntry:
+		typename = "RepoDestroyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoDestroyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoRemoveMemberAuditEntry:
+		typename = "RepoRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepoRemoveTopicAuditEntry:
+		typename = "RepoRemoveTopicAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepoRemoveTopicAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepository:
+		typename = "Repository"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepository
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepositoryInvitation:
+		typename = "RepositoryInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepositoryInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepositoryMigration:
+		typename = "RepositoryMigration"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepositoryMigration
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepositoryTopic:
+		typename = "RepositoryTopic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepositoryTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepositoryVisibilityChangeDisableAuditEntry:
+		typename = "RepositoryVisibilityChangeDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepositoryVisibilityChangeDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepositoryVisibilityChangeEnableAuditEntry:
+		typename = "RepositoryVisibilityChangeEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepositoryVisibilityChangeEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeRepositoryVulnerabilityAlert:
+		typename = "RepositoryVulnerabilityAlert"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeRepositoryVulnerabilityAlert
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReviewDismissalAllowance:
+		typename = "ReviewDismissalAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReviewDismissalAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReviewDismissedEvent:
+		typename = "ReviewDismissedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReviewDismissedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReviewRequest:
+		typename = "ReviewRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReviewRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReviewRequestRemovedEvent:
+		typename = "ReviewRequestRemovedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReviewRequestRemovedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeReviewRequestedEvent:
+		typename = "ReviewRequestedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeReviewRequestedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeSavedReply:
+		typename = "SavedReply"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeSavedReply
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeSecurityAdvisory:
+		typename = "SecurityAdvisory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeSecurityAdvisory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeSponsorsActivity:
+		typename = "SponsorsActivity"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeSponsorsActivity
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeSponsorsListing:
+		typename = "SponsorsListing"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeSponsorsListing
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeSponsorsListingFeaturedItem:
+		typename = "SponsorsListingFeaturedItem"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeSponsorsListingFeaturedItem
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeSponsorsTier:
+		typename = "SponsorsTier"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeSponsorsTier
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeSponsorship:
+		typename = "Sponsorship"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeSponsorship
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeSponsorshipNewsletter:
+		typename = "SponsorshipNewsletter"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeSponsorshipNewsletter
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeStatus:
+		typename = "Status"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeStatusCheckRollup:
+		typename = "StatusCheckRollup"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeStatusCheckRollup
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeStatusContext:
+		typename = "StatusContext"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeStatusContext
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeSubscribedEvent:
+		typename = "SubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeSubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTeam:
+		typename = "Team"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTeam
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTeamAddMemberAuditEntry:
+		typename = "TeamAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTeamAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTeamAddRepositoryAuditEntry:
+		typename = "TeamAddRepositoryAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTeamAddRepositoryAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTeamChangeParentTeamAuditEntry:
+		typename = "TeamChangeParentTeamAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTeamChangeParentTeamAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTeamDiscussion:
+		typename = "TeamDiscussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTeamDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTeamDiscussionComment:
+		typename = "TeamDiscussionComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTeamDiscussionComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTeamRemoveMemberAuditEntry:
+		typename = "TeamRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTeamRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTeamRemoveRepositoryAuditEntry:
+		typename = "TeamRemoveRepositoryAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTeamRemoveRepositoryAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTransferredEvent:
+		typename = "TransferredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTransferredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeTree
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUnassignedEvent:
+		typename = "UnassignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUnassignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUnlabeledEvent:
+		typename = "UnlabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUnlabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUnlockedEvent:
+		typename = "UnlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUnlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUnmarkedAsDuplicateEvent:
+		typename = "UnmarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUnmarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUnpinnedEvent:
+		typename = "UnpinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUnpinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUnsubscribedEvent:
+		typename = "UnsubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUnsubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUserBlockedEvent:
+		typename = "UserBlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUserBlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUserContentEdit:
+		typename = "UserContentEdit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUserContentEdit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeUserStatus:
+		typename = "UserStatus"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeUserStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeVerifiableDomain:
+		typename = "VerifiableDomain"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeVerifiableDomain
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeWorkflow:
+		typename = "Workflow"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeWorkflow
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPendingPullRequestReviewNodeWorkflowRun:
+		typename = "WorkflowRun"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPendingPullRequestReviewNodeWorkflowRun
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getPendingPullRequestReviewNode: "%T"`, v)
+	}
+}
+
+// getPendingPullRequestReviewNodeAddedToProjectEvent includes the requested fields of the GraphQL type AddedToProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'added_to_project' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeAddedToProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeAddedToProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeAddedToProjectEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeApp includes the requested fields of the GraphQL type App.
+// The GraphQL type's documentation follows.
+//
+// A GitHub App.
+type getPendingPullRequestReviewNodeApp struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeApp.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeApp) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeAssignedEvent includes the requested fields of the GraphQL type AssignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'assigned' event on any assignable object.
+type getPendingPullRequestReviewNodeAssignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeAssignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeAssignedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeAutoMergeDisabledEvent includes the requested fields of the GraphQL type AutoMergeDisabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_disabled' event on a given pull request.
+type getPendingPullRequestReviewNodeAutoMergeDisabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeAutoMergeDisabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeAutoMergeDisabledEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeAutoMergeEnabledEvent includes the requested fields of the GraphQL type AutoMergeEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_enabled' event on a given pull request.
+type getPendingPullRequestReviewNodeAutoMergeEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeAutoMergeEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeAutoMergeEnabledEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeAutoRebaseEnabledEvent includes the requested fields of the GraphQL type AutoRebaseEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_rebase_enabled' event on a given pull request.
+type getPendingPullRequestReviewNodeAutoRebaseEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeAutoRebaseEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeAutoRebaseEnabledEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeAutoSquashEnabledEvent includes the requested fields of the GraphQL type AutoSquashEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_squash_enabled' event on a given pull request.
+type getPendingPullRequestReviewNodeAutoSquashEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeAutoSquashEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeAutoSquashEnabledEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeAutomaticBaseChangeFailedEvent includes the requested fields of the GraphQL type AutomaticBaseChangeFailedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_failed' event on a given pull request.
+type getPendingPullRequestReviewNodeAutomaticBaseChangeFailedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeAutomaticBaseChangeFailedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeAutomaticBaseChangeFailedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeAutomaticBaseChangeSucceededEvent includes the requested fields of the GraphQL type AutomaticBaseChangeSucceededEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_succeeded' event on a given pull request.
+type getPendingPullRequestReviewNodeAutomaticBaseChangeSucceededEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeAutomaticBaseChangeSucceededEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeAutomaticBaseChangeSucceededEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeBaseRefChangedEvent includes the requested fields of the GraphQL type BaseRefChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_changed' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeBaseRefChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeBaseRefChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeBaseRefChangedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeBaseRefDeletedEvent includes the requested fields of the GraphQL type BaseRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_deleted' event on a given pull request.
+type getPendingPullRequestReviewNodeBaseRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeBaseRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeBaseRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeBaseRefForcePushedEvent includes the requested fields of the GraphQL type BaseRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_force_pushed' event on a given pull request.
+type getPendingPullRequestReviewNodeBaseRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeBaseRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeBaseRefForcePushedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type getPendingPullRequestReviewNodeBlob struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeBlob) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeBot includes the requested fields of the GraphQL type Bot.
+// The GraphQL type's documentation follows.
+//
+// A special type of user which takes actions on behalf of GitHub Apps.
+type getPendingPullRequestReviewNodeBot struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeBot.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeBot) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeBranchProtectionRule includes the requested fields of the GraphQL type BranchProtectionRule.
+// The GraphQL type's documentation follows.
+//
+// A branch protection rule.
+type getPendingPullRequestReviewNodeBranchProtectionRule struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeBranchProtectionRule.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeBranchProtectionRule) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeBypassForcePushAllowance includes the requested fields of the GraphQL type BypassForcePushAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to bypass a force push requirement on a protected branch.
+type getPendingPullRequestReviewNodeBypassForcePushAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeBypassForcePushAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeBypassForcePushAllowance) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeBypassPullRequestAllowance includes the requested fields of the GraphQL type BypassPullRequestAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to bypass a pull request requirement on a protected branch.
+type getPendingPullRequestReviewNodeBypassPullRequestAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeBypassPullRequestAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeBypassPullRequestAllowance) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeCWE includes the requested fields of the GraphQL type CWE.
+// The GraphQL type's documentation follows.
+//
+// A common weakness enumeration
+type getPendingPullRequestReviewNodeCWE struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeCWE.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeCWE) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeCheckRun includes the requested fields of the GraphQL type CheckRun.
+// The GraphQL type's documentation follows.
+//
+// A check run.
+type getPendingPullRequestReviewNodeCheckRun struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeCheckRun.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeCheckRun) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeCheckSuite includes the requested fields of the GraphQL type CheckSuite.
+// The GraphQL type's documentation follows.
+//
+// A check suite.
+type getPendingPullRequestReviewNodeCheckSuite struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeCheckSuite.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeCheckSuite) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeClosedEvent includes the requested fields of the GraphQL type ClosedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'closed' event on any `Closable`.
+type getPendingPullRequestReviewNodeClosedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeClosedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeClosedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeCodeOfConduct includes the requested fields of the GraphQL type CodeOfConduct.
+// The GraphQL type's documentation follows.
+//
+// The Code of Conduct for a repository
+type getPendingPullRequestReviewNodeCodeOfConduct struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeCodeOfConduct.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeCodeOfConduct) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeCommentDeletedEvent includes the requested fields of the GraphQL type CommentDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'comment_deleted' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeCommentDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeCommentDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeCommentDeletedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getPendingPullRequestReviewNodeCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeCommit) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeCommitComment includes the requested fields of the GraphQL type CommitComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on a given Commit.
+type getPendingPullRequestReviewNodeCommitComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeCommitComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeCommitComment) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeCommitCommentThread includes the requested fields of the GraphQL type CommitCommentThread.
+// The GraphQL type's documentation follows.
+//
+// A thread of comments on a commit.
+type getPendingPullRequestReviewNodeCommitCommentThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeCommitCommentThread.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeCommitCommentThread) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeComparison includes the requested fields of the GraphQL type Comparison.
+// The GraphQL type's documentation follows.
+//
+// Represents a comparison between two commit revisions.
+type getPendingPullRequestReviewNodeComparison struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeComparison.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeComparison) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeConnectedEvent includes the requested fields of the GraphQL type ConnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'connected' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeConnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeConnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeConnectedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeConvertToDraftEvent includes the requested fields of the GraphQL type ConvertToDraftEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'convert_to_draft' event on a given pull request.
+type getPendingPullRequestReviewNodeConvertToDraftEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeConvertToDraftEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeConvertToDraftEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeConvertedNoteToIssueEvent includes the requested fields of the GraphQL type ConvertedNoteToIssueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_note_to_issue' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeConvertedNoteToIssueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeConvertedNoteToIssueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeConvertedNoteToIssueEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeConvertedToDiscussionEvent includes the requested fields of the GraphQL type ConvertedToDiscussionEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_to_discussion' event on a given issue.
+type getPendingPullRequestReviewNodeConvertedToDiscussionEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeConvertedToDiscussionEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeConvertedToDiscussionEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeCrossReferencedEvent includes the requested fields of the GraphQL type CrossReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a mention made by one issue or pull request to another.
+type getPendingPullRequestReviewNodeCrossReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeCrossReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeCrossReferencedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDemilestonedEvent includes the requested fields of the GraphQL type DemilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'demilestoned' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeDemilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDemilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDemilestonedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDependencyGraphManifest includes the requested fields of the GraphQL type DependencyGraphManifest.
+// The GraphQL type's documentation follows.
+//
+// Dependency manifest for a repository
+type getPendingPullRequestReviewNodeDependencyGraphManifest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDependencyGraphManifest.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDependencyGraphManifest) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeDeployKey includes the requested fields of the GraphQL type DeployKey.
+// The GraphQL type's documentation follows.
+//
+// A repository deploy key.
+type getPendingPullRequestReviewNodeDeployKey struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDeployKey.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDeployKey) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDeployedEvent includes the requested fields of the GraphQL type DeployedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployed' event on a given pull request.
+type getPendingPullRequestReviewNodeDeployedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDeployedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDeployedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDeployment includes the requested fields of the GraphQL type Deployment.
+// The GraphQL type's documentation follows.
+//
+// Represents triggered deployment instance.
+type getPendingPullRequestReviewNodeDeployment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDeployment) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDeploymentEnvironmentChangedEvent includes the requested fields of the GraphQL type DeploymentEnvironmentChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployment_environment_changed' event on a given pull request.
+type getPendingPullRequestReviewNodeDeploymentEnvironmentChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDeploymentEnvironmentChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDeploymentEnvironmentChangedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeDeploymentReview includes the requested fields of the GraphQL type DeploymentReview.
+// The GraphQL type's documentation follows.
+//
+// A deployment review.
+type getPendingPullRequestReviewNodeDeploymentReview struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDeploymentReview.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDeploymentReview) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDeploymentStatus includes the requested fields of the GraphQL type DeploymentStatus.
+// The GraphQL type's documentation follows.
+//
+// Describes the status of a given deployment attempt.
+type getPendingPullRequestReviewNodeDeploymentStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDeploymentStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDeploymentStatus) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDisconnectedEvent includes the requested fields of the GraphQL type DisconnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'disconnected' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeDisconnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDisconnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDisconnectedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDiscussion includes the requested fields of the GraphQL type Discussion.
+// The GraphQL type's documentation follows.
+//
+// A discussion in a repository.
+type getPendingPullRequestReviewNodeDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDiscussion) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDiscussionCategory includes the requested fields of the GraphQL type DiscussionCategory.
+// The GraphQL type's documentation follows.
+//
+// A category for discussions in a repository.
+type getPendingPullRequestReviewNodeDiscussionCategory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDiscussionCategory.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDiscussionCategory) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDiscussionComment includes the requested fields of the GraphQL type DiscussionComment.
+// The GraphQL type's documentation follows.
+//
+// A comment on a discussion.
+type getPendingPullRequestReviewNodeDiscussionComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDiscussionComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDiscussionComment) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDiscussionPoll includes the requested fields of the GraphQL type DiscussionPoll.
+// The GraphQL type's documentation follows.
+//
+// A poll for a discussion.
+type getPendingPullRequestReviewNodeDiscussionPoll struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDiscussionPoll.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDiscussionPoll) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDiscussionPollOption includes the requested fields of the GraphQL type DiscussionPollOption.
+// The GraphQL type's documentation follows.
+//
+// An option for a discussion poll.
+type getPendingPullRequestReviewNodeDiscussionPollOption struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDiscussionPollOption.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDiscussionPollOption) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeDraftIssue includes the requested fields of the GraphQL type DraftIssue.
+// The GraphQL type's documentation follows.
+//
+// A draft issue within a project.
+type getPendingPullRequestReviewNodeDraftIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeDraftIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeDraftIssue) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeEnterprise includes the requested fields of the GraphQL type Enterprise.
+// The GraphQL type's documentation follows.
+//
+// An account to manage multiple organizations with consolidated policy and billing.
+type getPendingPullRequestReviewNodeEnterprise struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnterprise.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnterprise) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeEnterpriseAdministratorInvitation includes the requested fields of the GraphQL type EnterpriseAdministratorInvitation.
+// The GraphQL type's documentation follows.
+//
+// An invitation for a user to become an owner or billing manager of an enterprise.
+type getPendingPullRequestReviewNodeEnterpriseAdministratorInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnterpriseAdministratorInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnterpriseAdministratorInvitation) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeEnterpriseIdentityProvider includes the requested fields of the GraphQL type EnterpriseIdentityProvider.
+// The GraphQL type's documentation follows.
+//
+// An identity provider configured to provision identities for an enterprise.
+type getPendingPullRequestReviewNodeEnterpriseIdentityProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnterpriseIdentityProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnterpriseIdentityProvider) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeEnterpriseRepositoryInfo includes the requested fields of the GraphQL type EnterpriseRepositoryInfo.
+// The GraphQL type's documentation follows.
+//
+// A subset of repository information queryable from an enterprise.
+type getPendingPullRequestReviewNodeEnterpriseRepositoryInfo struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnterpriseRepositoryInfo.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnterpriseRepositoryInfo) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeEnterpriseServerInstallation includes the requested fields of the GraphQL type EnterpriseServerInstallation.
+// The GraphQL type's documentation follows.
+//
+// An Enterprise Server installation.
+type getPendingPullRequestReviewNodeEnterpriseServerInstallation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnterpriseServerInstallation.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnterpriseServerInstallation) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeEnterpriseServerUserAccount includes the requested fields of the GraphQL type EnterpriseServerUserAccount.
+// The GraphQL type's documentation follows.
+//
+// A user account on an Enterprise Server installation.
+type getPendingPullRequestReviewNodeEnterpriseServerUserAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnterpriseServerUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnterpriseServerUserAccount) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeEnterpriseServerUserAccountEmail includes the requested fields of the GraphQL type EnterpriseServerUserAccountEmail.
+// The GraphQL type's documentation follows.
+//
+// An email belonging to a user account on an Enterprise Server installation.
+type getPendingPullRequestReviewNodeEnterpriseServerUserAccountEmail struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnterpriseServerUserAccountEmail.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnterpriseServerUserAccountEmail) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeEnterpriseServerUserAccountsUpload includes the requested fields of the GraphQL type EnterpriseServerUserAccountsUpload.
+// The GraphQL type's documentation follows.
+//
+// A user accounts upload from an Enterprise Server installation.
+type getPendingPullRequestReviewNodeEnterpriseServerUserAccountsUpload struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnterpriseServerUserAccountsUpload.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnterpriseServerUserAccountsUpload) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// The GraphQL type's documentation follows.
+//
+// An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
+type getPendingPullRequestReviewNodeEnterpriseUserAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnterpriseUserAccount) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// An environment.
+type getPendingPullRequestReviewNodeEnvironment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeEnvironment.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeEnvironment) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeExternalIdentity includes the requested fields of the GraphQL type ExternalIdentity.
+// The GraphQL type's documentation follows.
+//
+// An external identity provisioned by SAML SSO or SCIM.
+type getPendingPullRequestReviewNodeExternalIdentity struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeExternalIdentity.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeExternalIdentity) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeGist includes the requested fields of the GraphQL type Gist.
+// The GraphQL type's documentation follows.
+//
+// A Gist.
+type getPendingPullRequestReviewNodeGist struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeGist.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeGist) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeGistComment includes the requested fields of the GraphQL type GistComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on an Gist.
+type getPendingPullRequestReviewNodeGistComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeGistComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeGistComment) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeHeadRefDeletedEvent includes the requested fields of the GraphQL type HeadRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_deleted' event on a given pull request.
+type getPendingPullRequestReviewNodeHeadRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeHeadRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeHeadRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeHeadRefForcePushedEvent includes the requested fields of the GraphQL type HeadRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_force_pushed' event on a given pull request.
+type getPendingPullRequestReviewNodeHeadRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeHeadRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeHeadRefForcePushedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeHeadRefRestoredEvent includes the requested fields of the GraphQL type HeadRefRestoredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_restored' event on a given pull request.
+type getPendingPullRequestReviewNodeHeadRefRestoredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeHeadRefRestoredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeHeadRefRestoredEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeIpAllowListEntry includes the requested fields of the GraphQL type IpAllowListEntry.
+// The GraphQL type's documentation follows.
+//
+// An IP address or range of addresses that is allowed to access an owner's resources.
+type getPendingPullRequestReviewNodeIpAllowListEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeIpAllowListEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeIpAllowListEntry) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type getPendingPullRequestReviewNodeIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeIssue) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeIssueComment includes the requested fields of the GraphQL type IssueComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on an Issue.
+type getPendingPullRequestReviewNodeIssueComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeIssueComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeIssueComment) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type getPendingPullRequestReviewNodeLabel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeLabel.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeLabel) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeLabeledEvent includes the requested fields of the GraphQL type LabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'labeled' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeLabeledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeLabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeLabeledEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeLanguage includes the requested fields of the GraphQL type Language.
+// The GraphQL type's documentation follows.
+//
+// Represents a given language found in repositories.
+type getPendingPullRequestReviewNodeLanguage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeLanguage.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeLanguage) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeLicense includes the requested fields of the GraphQL type License.
+// The GraphQL type's documentation follows.
+//
+// A repository's open source license
+type getPendingPullRequestReviewNodeLicense struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeLicense.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeLicense) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeLinkedBranch includes the requested fields of the GraphQL type LinkedBranch.
+// The GraphQL type's documentation follows.
+//
+// A branch linked to an issue.
+type getPendingPullRequestReviewNodeLinkedBranch struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeLinkedBranch.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeLinkedBranch) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeLockedEvent includes the requested fields of the GraphQL type LockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'locked' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeLockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeLockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeLockedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeMannequin includes the requested fields of the GraphQL type Mannequin.
+// The GraphQL type's documentation follows.
+//
+// A placeholder user for attribution of imported data on GitHub.
+type getPendingPullRequestReviewNodeMannequin struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMannequin) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeMarkedAsDuplicateEvent includes the requested fields of the GraphQL type MarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'marked_as_duplicate' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeMarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMarkedAsDuplicateEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeMarketplaceCategory includes the requested fields of the GraphQL type MarketplaceCategory.
+// The GraphQL type's documentation follows.
+//
+// A public description of a Marketplace category.
+type getPendingPullRequestReviewNodeMarketplaceCategory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMarketplaceCategory.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMarketplaceCategory) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeMarketplaceListing includes the requested fields of the GraphQL type MarketplaceListing.
+// The GraphQL type's documentation follows.
+//
+// A listing in the GitHub integration marketplace.
+type getPendingPullRequestReviewNodeMarketplaceListing struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMarketplaceListing.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMarketplaceListing) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeMembersCanDeleteReposClearAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposClearAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.clear event.
+type getPendingPullRequestReviewNodeMembersCanDeleteReposClearAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMembersCanDeleteReposClearAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMembersCanDeleteReposClearAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeMembersCanDeleteReposDisableAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.disable event.
+type getPendingPullRequestReviewNodeMembersCanDeleteReposDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMembersCanDeleteReposDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMembersCanDeleteReposDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeMembersCanDeleteReposEnableAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.enable event.
+type getPendingPullRequestReviewNodeMembersCanDeleteReposEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMembersCanDeleteReposEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMembersCanDeleteReposEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeMentionedEvent includes the requested fields of the GraphQL type MentionedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'mentioned' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeMentionedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMentionedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMentionedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeMergedEvent includes the requested fields of the GraphQL type MergedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'merged' event on a given pull request.
+type getPendingPullRequestReviewNodeMergedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMergedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMergedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeMigrationSource includes the requested fields of the GraphQL type MigrationSource.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) migration source.
+type getPendingPullRequestReviewNodeMigrationSource struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMigrationSource.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMigrationSource) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeMilestone includes the requested fields of the GraphQL type Milestone.
+// The GraphQL type's documentation follows.
+//
+// Represents a Milestone object on a given repository.
+type getPendingPullRequestReviewNodeMilestone struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMilestone.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMilestone) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeMilestonedEvent includes the requested fields of the GraphQL type MilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'milestoned' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeMilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMilestonedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeMovedColumnsInProjectEvent includes the requested fields of the GraphQL type MovedColumnsInProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'moved_columns_in_project' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeMovedColumnsInProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeMovedColumnsInProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeMovedColumnsInProjectEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOIDCProvider includes the requested fields of the GraphQL type OIDCProvider.
+// The GraphQL type's documentation follows.
+//
+// An OIDC identity provider configured to provision identities for an enterprise.
+type getPendingPullRequestReviewNodeOIDCProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOIDCProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOIDCProvider) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeOauthApplicationCreateAuditEntry includes the requested fields of the GraphQL type OauthApplicationCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a oauth_application.create event.
+type getPendingPullRequestReviewNodeOauthApplicationCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOauthApplicationCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOauthApplicationCreateAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgAddBillingManagerAuditEntry includes the requested fields of the GraphQL type OrgAddBillingManagerAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.add_billing_manager
+type getPendingPullRequestReviewNodeOrgAddBillingManagerAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgAddBillingManagerAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgAddBillingManagerAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgAddMemberAuditEntry includes the requested fields of the GraphQL type OrgAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.add_member
+type getPendingPullRequestReviewNodeOrgAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgAddMemberAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgBlockUserAuditEntry includes the requested fields of the GraphQL type OrgBlockUserAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.block_user
+type getPendingPullRequestReviewNodeOrgBlockUserAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgBlockUserAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgBlockUserAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgConfigDisableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type OrgConfigDisableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.config.disable_collaborators_only event.
+type getPendingPullRequestReviewNodeOrgConfigDisableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgConfigDisableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgConfigDisableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgConfigEnableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type OrgConfigEnableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.config.enable_collaborators_only event.
+type getPendingPullRequestReviewNodeOrgConfigEnableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgConfigEnableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgConfigEnableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgCreateAuditEntry includes the requested fields of the GraphQL type OrgCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.create event.
+type getPendingPullRequestReviewNodeOrgCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgCreateAuditEntry) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeOrgDisableOauthAppRestrictionsAuditEntry includes the requested fields of the GraphQL type OrgDisableOauthAppRestrictionsAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_oauth_app_restrictions event.
+type getPendingPullRequestReviewNodeOrgDisableOauthAppRestrictionsAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgDisableOauthAppRestrictionsAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgDisableOauthAppRestrictionsAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgDisableSamlAuditEntry includes the requested fields of the GraphQL type OrgDisableSamlAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_saml event.
+type getPendingPullRequestReviewNodeOrgDisableSamlAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgDisableSamlAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgDisableSamlAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgDisableTwoFactorRequirementAuditEntry includes the requested fields of the GraphQL type OrgDisableTwoFactorRequirementAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_two_factor_requirement event.
+type getPendingPullRequestReviewNodeOrgDisableTwoFactorRequirementAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgDisableTwoFactorRequirementAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgDisableTwoFactorRequirementAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgEnableOauthAppRestrictionsAuditEntry includes the requested fields of the GraphQL type OrgEnableOauthAppRestrictionsAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_oauth_app_restrictions event.
+type getPendingPullRequestReviewNodeOrgEnableOauthAppRestrictionsAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgEnableOauthAppRestrictionsAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgEnableOauthAppRestrictionsAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgEnableSamlAuditEntry includes the requested fields of the GraphQL type OrgEnableSamlAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_saml event.
+type getPendingPullRequestReviewNodeOrgEnableSamlAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgEnableSamlAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgEnableSamlAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgEnableTwoFactorRequirementAuditEntry includes the requested fields of the GraphQL type OrgEnableTwoFactorRequirementAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_two_factor_requirement event.
+type getPendingPullRequestReviewNodeOrgEnableTwoFactorRequirementAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgEnableTwoFactorRequirementAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgEnableTwoFactorRequirementAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgInviteMemberAuditEntry includes the requested fields of the GraphQL type OrgInviteMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.invite_member event.
+type getPendingPullRequestReviewNodeOrgInviteMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgInviteMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgInviteMemberAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgInviteToBusinessAuditEntry includes the requested fields of the GraphQL type OrgInviteToBusinessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.invite_to_business event.
+type getPendingPullRequestReviewNodeOrgInviteToBusinessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgInviteToBusinessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgInviteToBusinessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgOauthAppAccessApprovedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessApprovedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_approved event.
+type getPendingPullRequestReviewNodeOrgOauthAppAccessApprovedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgOauthAppAccessApprovedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgOauthAppAccessApprovedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgOauthAppAccessDeniedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessDeniedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_denied event.
+type getPendingPullRequestReviewNodeOrgOauthAppAccessDeniedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgOauthAppAccessDeniedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgOauthAppAccessDeniedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgOauthAppAccessRequestedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessRequestedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_requested event.
+type getPendingPullRequestReviewNodeOrgOauthAppAccessRequestedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgOauthAppAccessRequestedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgOauthAppAccessRequestedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgRemoveBillingManagerAuditEntry includes the requested fields of the GraphQL type OrgRemoveBillingManagerAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_billing_manager event.
+type getPendingPullRequestReviewNodeOrgRemoveBillingManagerAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgRemoveBillingManagerAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgRemoveBillingManagerAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgRemoveMemberAuditEntry includes the requested fields of the GraphQL type OrgRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_member event.
+type getPendingPullRequestReviewNodeOrgRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgRemoveMemberAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgRemoveOutsideCollaboratorAuditEntry includes the requested fields of the GraphQL type OrgRemoveOutsideCollaboratorAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_outside_collaborator event.
+type getPendingPullRequestReviewNodeOrgRemoveOutsideCollaboratorAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgRemoveOutsideCollaboratorAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgRemoveOutsideCollaboratorAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgRestoreMemberAuditEntry includes the requested fields of the GraphQL type OrgRestoreMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.restore_member event.
+type getPendingPullRequestReviewNodeOrgRestoreMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgRestoreMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgRestoreMemberAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgUnblockUserAuditEntry includes the requested fields of the GraphQL type OrgUnblockUserAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.unblock_user
+type getPendingPullRequestReviewNodeOrgUnblockUserAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgUnblockUserAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgUnblockUserAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgUpdateDefaultRepositoryPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateDefaultRepositoryPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_default_repository_permission
+type getPendingPullRequestReviewNodeOrgUpdateDefaultRepositoryPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgUpdateDefaultRepositoryPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgUpdateDefaultRepositoryPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgUpdateMemberAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member event.
+type getPendingPullRequestReviewNodeOrgUpdateMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgUpdateMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgUpdateMemberAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberRepositoryCreationPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member_repository_creation_permission event.
+type getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberRepositoryInvitationPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member_repository_invitation_permission event.
+type getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getPendingPullRequestReviewNodeOrganization struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrganization) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeOrganizationIdentityProvider includes the requested fields of the GraphQL type OrganizationIdentityProvider.
+// The GraphQL type's documentation follows.
+//
+// An Identity Provider configured to provision SAML and SCIM identities for Organizations
+type getPendingPullRequestReviewNodeOrganizationIdentityProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrganizationIdentityProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrganizationIdentityProvider) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrganizationInvitation includes the requested fields of the GraphQL type OrganizationInvitation.
+// The GraphQL type's documentation follows.
+//
+// An Invitation for a user to an organization.
+type getPendingPullRequestReviewNodeOrganizationInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrganizationInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrganizationInvitation) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeOrganizationMigration includes the requested fields of the GraphQL type OrganizationMigration.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) organization migration.
+type getPendingPullRequestReviewNodeOrganizationMigration struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeOrganizationMigration.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeOrganizationMigration) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodePackage includes the requested fields of the GraphQL type Package.
+// The GraphQL type's documentation follows.
+//
+// Information for an uploaded package.
+type getPendingPullRequestReviewNodePackage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePackage.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePackage) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePackageFile includes the requested fields of the GraphQL type PackageFile.
+// The GraphQL type's documentation follows.
+//
+// A file in a package version.
+type getPendingPullRequestReviewNodePackageFile struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePackageFile.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePackageFile) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePackageTag includes the requested fields of the GraphQL type PackageTag.
+// The GraphQL type's documentation follows.
+//
+// A version tag contains the mapping between a tag name and a version.
+type getPendingPullRequestReviewNodePackageTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePackageTag.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePackageTag) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePackageVersion includes the requested fields of the GraphQL type PackageVersion.
+// The GraphQL type's documentation follows.
+//
+// Information about a specific package version.
+type getPendingPullRequestReviewNodePackageVersion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePackageVersion.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePackageVersion) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePinnedDiscussion includes the requested fields of the GraphQL type PinnedDiscussion.
+// The GraphQL type's documentation follows.
+//
+// A Pinned Discussion is a discussion pinned to a repository's index page.
+type getPendingPullRequestReviewNodePinnedDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePinnedDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePinnedDiscussion) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePinnedEvent includes the requested fields of the GraphQL type PinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'pinned' event on a given issue or pull request.
+type getPendingPullRequestReviewNodePinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePinnedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePinnedIssue includes the requested fields of the GraphQL type PinnedIssue.
+// The GraphQL type's documentation follows.
+//
+// A Pinned Issue is a issue pinned to a repository's index page.
+type getPendingPullRequestReviewNodePinnedIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePinnedIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePinnedIssue) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePrivateRepositoryForkingDisableAuditEntry includes the requested fields of the GraphQL type PrivateRepositoryForkingDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a private_repository_forking.disable event.
+type getPendingPullRequestReviewNodePrivateRepositoryForkingDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePrivateRepositoryForkingDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePrivateRepositoryForkingDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodePrivateRepositoryForkingEnableAuditEntry includes the requested fields of the GraphQL type PrivateRepositoryForkingEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a private_repository_forking.enable event.
+type getPendingPullRequestReviewNodePrivateRepositoryForkingEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePrivateRepositoryForkingEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePrivateRepositoryForkingEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// Projects manage issues, pull requests and notes within a project owner.
+type getPendingPullRequestReviewNodeProject struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProject.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProject) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeProjectCard includes the requested fields of the GraphQL type ProjectCard.
+// The GraphQL type's documentation follows.
+//
+// A card in a project.
+type getPendingPullRequestReviewNodeProjectCard struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectCard.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectCard) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeProjectColumn includes the requested fields of the GraphQL type ProjectColumn.
+// The GraphQL type's documentation follows.
+//
+// A column inside a project.
+type getPendingPullRequestReviewNodeProjectColumn struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectColumn.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectColumn) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getPendingPullRequestReviewNodeProjectV2 struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeProjectV2Field includes the requested fields of the GraphQL type ProjectV2Field.
+// The GraphQL type's documentation follows.
+//
+// A field inside a project.
+type getPendingPullRequestReviewNodeProjectV2Field struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2Field.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2Field) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeProjectV2Item includes the requested fields of the GraphQL type ProjectV2Item.
+// The GraphQL type's documentation follows.
+//
+// An item within a Project.
+type getPendingPullRequestReviewNodeProjectV2Item struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2Item.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2Item) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeProjectV2ItemFieldDateValue includes the requested fields of the GraphQL type ProjectV2ItemFieldDateValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a date field in a Project item.
+type getPendingPullRequestReviewNodeProjectV2ItemFieldDateValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2ItemFieldDateValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldDateValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeProjectV2ItemFieldIterationValue includes the requested fields of the GraphQL type ProjectV2ItemFieldIterationValue.
+// The GraphQL type's documentation follows.
+//
+// The value of an iteration field in a Project item.
+type getPendingPullRequestReviewNodeProjectV2ItemFieldIterationValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2ItemFieldIterationValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldIterationValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeProjectV2ItemFieldNumberValue includes the requested fields of the GraphQL type ProjectV2ItemFieldNumberValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a number field in a Project item.
+type getPendingPullRequestReviewNodeProjectV2ItemFieldNumberValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2ItemFieldNumberValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldNumberValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeProjectV2ItemFieldSingleSelectValue includes the requested fields of the GraphQL type ProjectV2ItemFieldSingleSelectValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a single select field in a Project item.
+type getPendingPullRequestReviewNodeProjectV2ItemFieldSingleSelectValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2ItemFieldSingleSelectValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldSingleSelectValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeProjectV2ItemFieldTextValue includes the requested fields of the GraphQL type ProjectV2ItemFieldTextValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a text field in a Project item.
+type getPendingPullRequestReviewNodeProjectV2ItemFieldTextValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2ItemFieldTextValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2ItemFieldTextValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeProjectV2IterationField includes the requested fields of the GraphQL type ProjectV2IterationField.
+// The GraphQL type's documentation follows.
+//
+// An iteration field inside a project.
+type getPendingPullRequestReviewNodeProjectV2IterationField struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2IterationField.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2IterationField) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeProjectV2SingleSelectField includes the requested fields of the GraphQL type ProjectV2SingleSelectField.
+// The GraphQL type's documentation follows.
+//
+// A single select field inside a project.
+type getPendingPullRequestReviewNodeProjectV2SingleSelectField struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2SingleSelectField.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2SingleSelectField) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeProjectV2View includes the requested fields of the GraphQL type ProjectV2View.
+// The GraphQL type's documentation follows.
+//
+// A view within a ProjectV2.
+type getPendingPullRequestReviewNodeProjectV2View struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2View.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2View) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeProjectV2Workflow includes the requested fields of the GraphQL type ProjectV2Workflow.
+// The GraphQL type's documentation follows.
+//
+// A workflow inside a project.
+type getPendingPullRequestReviewNodeProjectV2Workflow struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeProjectV2Workflow.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeProjectV2Workflow) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePublicKey includes the requested fields of the GraphQL type PublicKey.
+// The GraphQL type's documentation follows.
+//
+// A user's public key.
+type getPendingPullRequestReviewNodePublicKey struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePublicKey.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePublicKey) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPendingPullRequestReviewNodePullRequest struct {
+	Typename string `json:"__typename"`
+	// A list of reviews associated with the pull request.
+	Reviews *getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnection `json:"reviews"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePullRequest.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequest) GetTypename() string { return v.Typename }
+
+// GetReviews returns getPendingPullRequestReviewNodePullRequest.Reviews, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequest) GetReviews() *getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnection {
+	return v.Reviews
+}
+
+// getPendingPullRequestReviewNodePullRequestCommit includes the requested fields of the GraphQL type PullRequestCommit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit part of a pull request.
+type getPendingPullRequestReviewNodePullRequestCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePullRequestCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequestCommit) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePullRequestCommitCommentThread includes the requested fields of the GraphQL type PullRequestCommitCommentThread.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit comment thread part of a pull request.
+type getPendingPullRequestReviewNodePullRequestCommitCommentThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePullRequestCommitCommentThread.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequestCommitCommentThread) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodePullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// The GraphQL type's documentation follows.
+//
+// A review object for a given pull request.
+type getPendingPullRequestReviewNodePullRequestReview struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePullRequestReview.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequestReview) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePullRequestReviewComment includes the requested fields of the GraphQL type PullRequestReviewComment.
+// The GraphQL type's documentation follows.
+//
+// A review comment associated with a given repository pull request.
+type getPendingPullRequestReviewNodePullRequestReviewComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePullRequestReviewComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequestReviewComment) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodePullRequestReviewThread includes the requested fields of the GraphQL type PullRequestReviewThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type getPendingPullRequestReviewNodePullRequestReviewThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePullRequestReviewThread.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequestReviewThread) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnection includes the requested fields of the GraphQL type PullRequestReviewConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequestReview.
+type getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnection struct {
+	// A list of nodes.
+	Nodes []*getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnectionNodesPullRequestReview `json:"nodes"`
+}
+
+// GetNodes returns getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnection) GetNodes() []*getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnectionNodesPullRequestReview {
+	return v.Nodes
+}
+
+// getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnectionNodesPullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// The GraphQL type's documentation follows.
+//
+// A review object for a given pull request.
+type getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnectionNodesPullRequestReview struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnectionNodesPullRequestReview.Id, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequestReviewsPullRequestReviewConnectionNodesPullRequestReview) GetId() string {
+	return v.Id
+}
+
+// getPendingPullRequestReviewNodePullRequestThread includes the requested fields of the GraphQL type PullRequestThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type getPendingPullRequestReviewNodePullRequestThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePullRequestThread.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePullRequestThread) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePush includes the requested fields of the GraphQL type Push.
+// The GraphQL type's documentation follows.
+//
+// A Git push.
+type getPendingPullRequestReviewNodePush struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePush.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePush) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodePushAllowance includes the requested fields of the GraphQL type PushAllowance.
+// The GraphQL type's documentation follows.
+//
+// A team, user, or app who has the ability to push to a protected branch.
+type getPendingPullRequestReviewNodePushAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodePushAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodePushAllowance) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeReaction includes the requested fields of the GraphQL type Reaction.
+// The GraphQL type's documentation follows.
+//
+// An emoji reaction to a particular piece of content.
+type getPendingPullRequestReviewNodeReaction struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReaction.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReaction) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeReadyForReviewEvent includes the requested fields of the GraphQL type ReadyForReviewEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'ready_for_review' event on a given pull request.
+type getPendingPullRequestReviewNodeReadyForReviewEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReadyForReviewEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReadyForReviewEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type getPendingPullRequestReviewNodeRef struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRef.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRef) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeReferencedEvent includes the requested fields of the GraphQL type ReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'referenced' event on a given `ReferencedSubject`.
+type getPendingPullRequestReviewNodeReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReferencedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRelease includes the requested fields of the GraphQL type Release.
+// The GraphQL type's documentation follows.
+//
+// A release contains the content for a release.
+type getPendingPullRequestReviewNodeRelease struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRelease.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRelease) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeReleaseAsset includes the requested fields of the GraphQL type ReleaseAsset.
+// The GraphQL type's documentation follows.
+//
+// A release asset contains the content for a release asset.
+type getPendingPullRequestReviewNodeReleaseAsset struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReleaseAsset.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReleaseAsset) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRemovedFromProjectEvent includes the requested fields of the GraphQL type RemovedFromProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'removed_from_project' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeRemovedFromProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRemovedFromProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRemovedFromProjectEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRenamedTitleEvent includes the requested fields of the GraphQL type RenamedTitleEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'renamed' event on a given issue or pull request
+type getPendingPullRequestReviewNodeRenamedTitleEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRenamedTitleEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRenamedTitleEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeReopenedEvent includes the requested fields of the GraphQL type ReopenedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'reopened' event on any `Closable`.
+type getPendingPullRequestReviewNodeReopenedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReopenedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReopenedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRepoAccessAuditEntry includes the requested fields of the GraphQL type RepoAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.access event.
+type getPendingPullRequestReviewNodeRepoAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoAccessAuditEntry) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRepoAddMemberAuditEntry includes the requested fields of the GraphQL type RepoAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.add_member event.
+type getPendingPullRequestReviewNodeRepoAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoAddMemberAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoAddTopicAuditEntry includes the requested fields of the GraphQL type RepoAddTopicAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.add_topic event.
+type getPendingPullRequestReviewNodeRepoAddTopicAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoAddTopicAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoAddTopicAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoArchivedAuditEntry includes the requested fields of the GraphQL type RepoArchivedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.archived event.
+type getPendingPullRequestReviewNodeRepoArchivedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoArchivedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoArchivedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoChangeMergeSettingAuditEntry includes the requested fields of the GraphQL type RepoChangeMergeSettingAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.change_merge_setting event.
+type getPendingPullRequestReviewNodeRepoChangeMergeSettingAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoChangeMergeSettingAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoChangeMergeSettingAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigDisableAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_anonymous_git_access event.
+type getPendingPullRequestReviewNodeRepoConfigDisableAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigDisableAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigDisableAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigDisableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_collaborators_only event.
+type getPendingPullRequestReviewNodeRepoConfigDisableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigDisableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigDisableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigDisableContributorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableContributorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_contributors_only event.
+type getPendingPullRequestReviewNodeRepoConfigDisableContributorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigDisableContributorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigDisableContributorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigDisableSockpuppetDisallowedAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableSockpuppetDisallowedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_sockpuppet_disallowed event.
+type getPendingPullRequestReviewNodeRepoConfigDisableSockpuppetDisallowedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigDisableSockpuppetDisallowedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigDisableSockpuppetDisallowedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigEnableAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_anonymous_git_access event.
+type getPendingPullRequestReviewNodeRepoConfigEnableAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigEnableAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigEnableAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigEnableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_collaborators_only event.
+type getPendingPullRequestReviewNodeRepoConfigEnableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigEnableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigEnableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigEnableContributorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableContributorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_contributors_only event.
+type getPendingPullRequestReviewNodeRepoConfigEnableContributorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigEnableContributorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigEnableContributorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigEnableSockpuppetDisallowedAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableSockpuppetDisallowedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_sockpuppet_disallowed event.
+type getPendingPullRequestReviewNodeRepoConfigEnableSockpuppetDisallowedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigEnableSockpuppetDisallowedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigEnableSockpuppetDisallowedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigLockAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigLockAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.lock_anonymous_git_access event.
+type getPendingPullRequestReviewNodeRepoConfigLockAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigLockAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigLockAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoConfigUnlockAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigUnlockAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.unlock_anonymous_git_access event.
+type getPendingPullRequestReviewNodeRepoConfigUnlockAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoConfigUnlockAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoConfigUnlockAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoCreateAuditEntry includes the requested fields of the GraphQL type RepoCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.create event.
+type getPendingPullRequestReviewNodeRepoCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoCreateAuditEntry) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRepoDestroyAuditEntry includes the requested fields of the GraphQL type RepoDestroyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.destroy event.
+type getPendingPullRequestReviewNodeRepoDestroyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoDestroyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoDestroyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoRemoveMemberAuditEntry includes the requested fields of the GraphQL type RepoRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.remove_member event.
+type getPendingPullRequestReviewNodeRepoRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoRemoveMemberAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepoRemoveTopicAuditEntry includes the requested fields of the GraphQL type RepoRemoveTopicAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.remove_topic event.
+type getPendingPullRequestReviewNodeRepoRemoveTopicAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepoRemoveTopicAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepoRemoveTopicAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPendingPullRequestReviewNodeRepository struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepository.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepository) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRepositoryInvitation includes the requested fields of the GraphQL type RepositoryInvitation.
+// The GraphQL type's documentation follows.
+//
+// An invitation for a user to be added to a repository.
+type getPendingPullRequestReviewNodeRepositoryInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepositoryInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepositoryInvitation) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRepositoryMigration includes the requested fields of the GraphQL type RepositoryMigration.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) repository migration.
+type getPendingPullRequestReviewNodeRepositoryMigration struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepositoryMigration.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepositoryMigration) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRepositoryTopic includes the requested fields of the GraphQL type RepositoryTopic.
+// The GraphQL type's documentation follows.
+//
+// A repository-topic connects a repository to a topic.
+type getPendingPullRequestReviewNodeRepositoryTopic struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepositoryTopic.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepositoryTopic) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeRepositoryVisibilityChangeDisableAuditEntry includes the requested fields of the GraphQL type RepositoryVisibilityChangeDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repository_visibility_change.disable event.
+type getPendingPullRequestReviewNodeRepositoryVisibilityChangeDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepositoryVisibilityChangeDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepositoryVisibilityChangeDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepositoryVisibilityChangeEnableAuditEntry includes the requested fields of the GraphQL type RepositoryVisibilityChangeEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repository_visibility_change.enable event.
+type getPendingPullRequestReviewNodeRepositoryVisibilityChangeEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepositoryVisibilityChangeEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepositoryVisibilityChangeEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeRepositoryVulnerabilityAlert includes the requested fields of the GraphQL type RepositoryVulnerabilityAlert.
+// The GraphQL type's documentation follows.
+//
+// A Dependabot alert for a repository with a dependency affected by a security vulnerability.
+type getPendingPullRequestReviewNodeRepositoryVulnerabilityAlert struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeRepositoryVulnerabilityAlert.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeRepositoryVulnerabilityAlert) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeReviewDismissalAllowance includes the requested fields of the GraphQL type ReviewDismissalAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to dismiss a review on a protected branch.
+type getPendingPullRequestReviewNodeReviewDismissalAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReviewDismissalAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReviewDismissalAllowance) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeReviewDismissedEvent includes the requested fields of the GraphQL type ReviewDismissedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'review_dismissed' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeReviewDismissedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReviewDismissedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReviewDismissedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeReviewRequest includes the requested fields of the GraphQL type ReviewRequest.
+// The GraphQL type's documentation follows.
+//
+// A request for a user to review a pull request.
+type getPendingPullRequestReviewNodeReviewRequest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReviewRequest.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReviewRequest) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeReviewRequestRemovedEvent includes the requested fields of the GraphQL type ReviewRequestRemovedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_request_removed' event on a given pull request.
+type getPendingPullRequestReviewNodeReviewRequestRemovedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReviewRequestRemovedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReviewRequestRemovedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeReviewRequestedEvent includes the requested fields of the GraphQL type ReviewRequestedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_requested' event on a given pull request.
+type getPendingPullRequestReviewNodeReviewRequestedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeReviewRequestedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeReviewRequestedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeSavedReply includes the requested fields of the GraphQL type SavedReply.
+// The GraphQL type's documentation follows.
+//
+// A Saved Reply is text a user can use to reply quickly.
+type getPendingPullRequestReviewNodeSavedReply struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeSavedReply.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeSavedReply) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeSecurityAdvisory includes the requested fields of the GraphQL type SecurityAdvisory.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Security Advisory
+type getPendingPullRequestReviewNodeSecurityAdvisory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeSecurityAdvisory.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeSecurityAdvisory) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeSponsorsActivity includes the requested fields of the GraphQL type SponsorsActivity.
+// The GraphQL type's documentation follows.
+//
+// An event related to sponsorship activity.
+type getPendingPullRequestReviewNodeSponsorsActivity struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeSponsorsActivity.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeSponsorsActivity) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeSponsorsListing includes the requested fields of the GraphQL type SponsorsListing.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Sponsors listing.
+type getPendingPullRequestReviewNodeSponsorsListing struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeSponsorsListing.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeSponsorsListing) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeSponsorsListingFeaturedItem includes the requested fields of the GraphQL type SponsorsListingFeaturedItem.
+// The GraphQL type's documentation follows.
+//
+// A record that is promoted on a GitHub Sponsors profile.
+type getPendingPullRequestReviewNodeSponsorsListingFeaturedItem struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeSponsorsListingFeaturedItem.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeSponsorsListingFeaturedItem) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeSponsorsTier includes the requested fields of the GraphQL type SponsorsTier.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Sponsors tier associated with a GitHub Sponsors listing.
+type getPendingPullRequestReviewNodeSponsorsTier struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeSponsorsTier.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeSponsorsTier) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeSponsorship includes the requested fields of the GraphQL type Sponsorship.
+// The GraphQL type's documentation follows.
+//
+// A sponsorship relationship between a sponsor and a maintainer
+type getPendingPullRequestReviewNodeSponsorship struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeSponsorship.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeSponsorship) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeSponsorshipNewsletter includes the requested fields of the GraphQL type SponsorshipNewsletter.
+// The GraphQL type's documentation follows.
+//
+// An update sent to sponsors of a user or organization on GitHub Sponsors.
+type getPendingPullRequestReviewNodeSponsorshipNewsletter struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeSponsorshipNewsletter.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeSponsorshipNewsletter) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeStatus includes the requested fields of the GraphQL type Status.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit status.
+type getPendingPullRequestReviewNodeStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeStatus) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
+// The GraphQL type's documentation follows.
+//
+// Represents the rollup for both the check runs and status for a commit.
+type getPendingPullRequestReviewNodeStatusCheckRollup struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeStatusCheckRollup.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeStatusCheckRollup) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeStatusContext includes the requested fields of the GraphQL type StatusContext.
+// The GraphQL type's documentation follows.
+//
+// Represents an individual commit status context
+type getPendingPullRequestReviewNodeStatusContext struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeStatusContext.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeStatusContext) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeSubscribedEvent includes the requested fields of the GraphQL type SubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'subscribed' event on a given `Subscribable`.
+type getPendingPullRequestReviewNodeSubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeSubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeSubscribedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getPendingPullRequestReviewNodeTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTag.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTag) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// A team of users in an organization.
+type getPendingPullRequestReviewNodeTeam struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTeam.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTeam) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeTeamAddMemberAuditEntry includes the requested fields of the GraphQL type TeamAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.add_member event.
+type getPendingPullRequestReviewNodeTeamAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTeamAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTeamAddMemberAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeTeamAddRepositoryAuditEntry includes the requested fields of the GraphQL type TeamAddRepositoryAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.add_repository event.
+type getPendingPullRequestReviewNodeTeamAddRepositoryAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTeamAddRepositoryAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTeamAddRepositoryAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeTeamChangeParentTeamAuditEntry includes the requested fields of the GraphQL type TeamChangeParentTeamAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.change_parent_team event.
+type getPendingPullRequestReviewNodeTeamChangeParentTeamAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTeamChangeParentTeamAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTeamChangeParentTeamAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeTeamDiscussion includes the requested fields of the GraphQL type TeamDiscussion.
+// The GraphQL type's documentation follows.
+//
+// A team discussion.
+type getPendingPullRequestReviewNodeTeamDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTeamDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTeamDiscussion) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeTeamDiscussionComment includes the requested fields of the GraphQL type TeamDiscussionComment.
+// The GraphQL type's documentation follows.
+//
+// A comment on a team discussion.
+type getPendingPullRequestReviewNodeTeamDiscussionComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTeamDiscussionComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTeamDiscussionComment) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeTeamRemoveMemberAuditEntry includes the requested fields of the GraphQL type TeamRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.remove_member event.
+type getPendingPullRequestReviewNodeTeamRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTeamRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTeamRemoveMemberAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeTeamRemoveRepositoryAuditEntry includes the requested fields of the GraphQL type TeamRemoveRepositoryAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.remove_repository event.
+type getPendingPullRequestReviewNodeTeamRemoveRepositoryAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTeamRemoveRepositoryAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTeamRemoveRepositoryAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeTopic includes the requested fields of the GraphQL type Topic.
+// The GraphQL type's documentation follows.
+//
+// A topic aggregates entities that are related to a subject.
+type getPendingPullRequestReviewNodeTopic struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTopic.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTopic) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeTransferredEvent includes the requested fields of the GraphQL type TransferredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'transferred' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeTransferredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTransferredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTransferredEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getPendingPullRequestReviewNodeTree struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeTree.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeTree) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeUnassignedEvent includes the requested fields of the GraphQL type UnassignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unassigned' event on any assignable object.
+type getPendingPullRequestReviewNodeUnassignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUnassignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUnassignedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeUnlabeledEvent includes the requested fields of the GraphQL type UnlabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlabeled' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeUnlabeledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUnlabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUnlabeledEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeUnlockedEvent includes the requested fields of the GraphQL type UnlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlocked' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeUnlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUnlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUnlockedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeUnmarkedAsDuplicateEvent includes the requested fields of the GraphQL type UnmarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unmarked_as_duplicate' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeUnmarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUnmarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUnmarkedAsDuplicateEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPendingPullRequestReviewNodeUnpinnedEvent includes the requested fields of the GraphQL type UnpinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unpinned' event on a given issue or pull request.
+type getPendingPullRequestReviewNodeUnpinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUnpinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUnpinnedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeUnsubscribedEvent includes the requested fields of the GraphQL type UnsubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unsubscribed' event on a given `Subscribable`.
+type getPendingPullRequestReviewNodeUnsubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUnsubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUnsubscribedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getPendingPullRequestReviewNodeUser struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUser.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUser) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeUserBlockedEvent includes the requested fields of the GraphQL type UserBlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'user_blocked' event on a given user.
+type getPendingPullRequestReviewNodeUserBlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUserBlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUserBlockedEvent) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeUserContentEdit includes the requested fields of the GraphQL type UserContentEdit.
+// The GraphQL type's documentation follows.
+//
+// An edit on user content
+type getPendingPullRequestReviewNodeUserContentEdit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUserContentEdit.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUserContentEdit) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeUserStatus includes the requested fields of the GraphQL type UserStatus.
+// The GraphQL type's documentation follows.
+//
+// The user's description of what they're currently doing.
+type getPendingPullRequestReviewNodeUserStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeUserStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeUserStatus) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeVerifiableDomain includes the requested fields of the GraphQL type VerifiableDomain.
+// The GraphQL type's documentation follows.
+//
+// A domain that can be verified or approved for an organization or an enterprise.
+type getPendingPullRequestReviewNodeVerifiableDomain struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeVerifiableDomain.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeVerifiableDomain) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeWorkflow includes the requested fields of the GraphQL type Workflow.
+// The GraphQL type's documentation follows.
+//
+// A workflow contains meta information about an Actions workflow file.
+type getPendingPullRequestReviewNodeWorkflow struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeWorkflow.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeWorkflow) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewNodeWorkflowRun includes the requested fields of the GraphQL type WorkflowRun.
+// The GraphQL type's documentation follows.
+//
+// A workflow run.
+type getPendingPullRequestReviewNodeWorkflowRun struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPendingPullRequestReviewNodeWorkflowRun.Typename, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewNodeWorkflowRun) GetTypename() string { return v.Typename }
+
+// getPendingPullRequestReviewResponse is returned by getPendingPullRequestReview on success.
+type getPendingPullRequestReviewResponse struct {
+	// Fetches an object given its ID.
+	Node getPendingPullRequestReviewNode `json:"-"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetNode returns getPendingPullRequestReviewResponse.Node, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewResponse) GetNode() getPendingPullRequestReviewNode {
+	return v.Node
+}
+
+// GetRateLimit returns getPendingPullRequestReviewResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *getPendingPullRequestReviewResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+func (v *getPendingPullRequestReviewResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getPendingPullRequestReviewResponse
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getPendingPullRequestReviewResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetPendingPullRequestReviewNode(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal getPendingPullRequestReviewResponse.Node: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetPendingPullRequestReviewResponse struct {
+	Node json.RawMessage `json:"node"`
+
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+func (v *getPendingPullRequestReviewResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getPendingPullRequestReviewResponse) __premarshalJSON() (*__premarshalgetPendingPullRequestReviewResponse, error) {
+	var retval __premarshalgetPendingPullRequestReviewResponse
+
+	{
+
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalgetPendingPullRequestReviewNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal getPendingPullRequestReviewResponse.Node: %w", err)
+		}
+	}
+	retval.RateLimit = v.RateLimit
+	return &retval, nil
+}
+
+// getPullRequestChecksRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestChecksRepository struct {
+	Id string `json:"id"`
+	// Returns a single pull request from the current repository by number.
+	PullRequest *getPullRequestChecksRepositoryPullRequest `json:"pullRequest"`
+}
+
+// GetId returns getPullRequestChecksRepository.Id, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepository) GetId() string { return v.Id }
+
+// GetPullRequest returns getPullRequestChecksRepository.PullRequest, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepository) GetPullRequest() *getPullRequestChecksRepositoryPullRequest {
+	return v.PullRequest
+}
+
+// getPullRequestChecksRepositoryPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPullRequestChecksRepositoryPullRequest struct {
+	// A list of commits present in this pull request's head branch not present in the base branch.
+	Commits *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnection `json:"commits"`
+}
+
+// GetCommits returns getPullRequestChecksRepositoryPullRequest.Commits, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequest) GetCommits() *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnection {
+	return v.Commits
+}
+
+// getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnection includes the requested fields of the GraphQL type PullRequestCommitConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequestCommit.
+type getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnection struct {
+	// A list of nodes.
+	Nodes []*getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommit `json:"nodes"`
+}
+
+// GetNodes returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnection) GetNodes() []*getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommit {
+	return v.Nodes
+}
+
+// getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommit includes the requested fields of the GraphQL type PullRequestCommit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit part of a pull request.
+type getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommit struct {
+	// The Git commit object
+	Commit *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit `json:"commit"`
+}
+
+// GetCommit returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommit.Commit, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommit) GetCommit() *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit {
+	return v.Commit
+}
+
+// getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit struct {
+	// The Git object ID
+	Oid string `json:"oid"`
+	// The check suites associated with a commit.
+	CheckSuites *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnection `json:"checkSuites"`
+	// Status information for this commit
+	Status *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatus `json:"status"`
+}
+
+// GetOid returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit.Oid, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit) GetOid() string {
+	return v.Oid
+}
+
+// GetCheckSuites returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit.CheckSuites, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit) GetCheckSuites() *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnection {
+	return v.CheckSuites
+}
+
+// GetStatus returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit.Status, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommit) GetStatus() *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatus {
+	return v.Status
+}
+
+// getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnection includes the requested fields of the GraphQL type CheckSuiteConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for CheckSuite.
+type getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnection struct {
+	// A list of nodes.
+	Nodes []*getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite `json:"nodes"`
+}
+
+// GetNodes returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnection) GetNodes() []*getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite {
+	return v.Nodes
+}
+
+// getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite includes the requested fields of the GraphQL type CheckSuite.
+// The GraphQL type's documentation follows.
+//
+// A check suite.
+type getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite struct {
+	Id string `json:"id"`
+	// The GitHub App which created this check suite.
+	App *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteApp `json:"app"`
+	// The check runs associated with a check suite.
+	CheckRuns *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteCheckRunsCheckRunConnection `json:"checkRuns"`
+}
+
+// GetId returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite.Id, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite) GetId() string {
+	return v.Id
+}
+
+// GetApp returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite.App, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite) GetApp() *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteApp {
+	return v.App
+}
+
+// GetCheckRuns returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite.CheckRuns, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuite) GetCheckRuns() *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteCheckRunsCheckRunConnection {
+	return v.CheckRuns
+}
+
+// getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteApp includes the requested fields of the GraphQL type App.
+// The GraphQL type's documentation follows.
+//
+// A GitHub App.
+type getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteApp struct {
+	// The name of the app.
+	Name string `json:"name"`
+}
+
+// GetName returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteApp.Name, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteApp) GetName() string {
+	return v.Name
+}
+
+// getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteCheckRunsCheckRunConnection includes the requested fields of the GraphQL type CheckRunConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for CheckRun.
+type getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteCheckRunsCheckRunConnection struct {
+	// A list of nodes.
+	Nodes []*CheckRunFields `json:"nodes"`
+}
+
+// GetNodes returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteCheckRunsCheckRunConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitCheckSuitesCheckSuiteConnectionNodesCheckSuiteCheckRunsCheckRunConnection) GetNodes() []*CheckRunFields {
+	return v.Nodes
+}
+
+// getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatus includes the requested fields of the GraphQL type Status.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit status.
+type getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatus struct {
+	// The individual status contexts for this commit.
+	Contexts []*StatusContextFields `json:"contexts"`
+}
+
+// GetContexts returns getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatus.Contexts, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksRepositoryPullRequestCommitsPullRequestCommitConnectionNodesPullRequestCommitCommitStatus) GetContexts() []*StatusContextFields {
+	return v.Contexts
+}
+
+// getPullRequestChecksResponse is returned by getPullRequestChecks on success.
+type getPullRequestChecksResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository *getPullRequestChecksRepository `json:"repository"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetRepository returns getPullRequestChecksResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksResponse) GetRepository() *getPullRequestChecksRepository {
+	return v.Repository
+}
+
+// GetRateLimit returns getPullRequestChecksResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *getPullRequestChecksResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+// getPullRequestDetailsNode includes the requested fields of the GraphQL interface Node.
+//
+// getPullRequestDetailsNode is implemented by the following types:
+// getPullRequestDetailsNodeAddedToProjectEvent
+// getPullRequestDetailsNodeApp
+// getPullRequestDetailsNodeAssignedEvent
+// getPullRequestDetailsNodeAutoMergeDisabledEvent
+// getPullRequestDetailsNodeAutoMergeEnabledEvent
+// getPullRequestDetailsNodeAutoRebaseEnabledEvent
+// getPullRequestDetailsNodeAutoSquashEnabledEvent
+// getPullRequestDetailsNodeAutomaticBaseChangeFailedEvent
+// getPullRequestDetailsNodeAutomaticBaseChangeSucceededEvent
+// getPullRequestDetailsNodeBaseRefChangedEvent
+// getPullRequestDetailsNodeBaseRefDeletedEvent
+// getPullRequestDetailsNodeBaseRefForcePushedEvent
+// getPullRequestDetailsNodeBlob
+// getPullRequestDetailsNodeBot
+// getPullRequestDetailsNodeBranchProtectionRule
+// getPullRequestDetailsNodeBypassForcePushAllowance
+// getPullRequestDetailsNodeBypassPullRequestAllowance
+// getPullRequestDetailsNodeCWE
+// getPullRequestDetailsNodeCheckRun
+// getPullRequestDetailsNodeCheckSuite
+// getPullRequestDetailsNodeClosedEvent
+// getPullRequestDetailsNodeCodeOfConduct
+// getPullRequestDetailsNodeCommentDeletedEvent
+// getPullRequestDetailsNodeCommit
+// getPullRequestDetailsNodeCommitComment
+// getPullRequestDetailsNodeCommitCommentThread
+// getPullRequestDetailsNodeComparison
+// getPullRequestDetailsNodeConnectedEvent
+// getPullRequestDetailsNodeConvertToDraftEvent
+// getPullRequestDetailsNodeConvertedNoteToIssueEvent
+// getPullRequestDetailsNodeConvertedToDiscussionEvent
+// getPullRequestDetailsNodeCrossReferencedEvent
+// getPullRequestDetailsNodeDemilestonedEvent
+// getPullRequestDetailsNodeDependencyGraphManifest
+// getPullRequestDetailsNodeDeployKey
+// getPullRequestDetailsNodeDeployedEvent
+// getPullRequestDetailsNodeDeployment
+// getPullRequestDetailsNodeDeploymentEnvironmentChangedEvent
+// getPullRequestDetailsNodeDeploymentReview
+// getPullRequestDetailsNodeDeploymentStatus
+// getPullRequestDetailsNodeDisconnectedEvent
+// getPullRequestDetailsNodeDiscussion
+// getPullRequestDetailsNodeDiscussionCategory
+// getPullRequestDetailsNodeDiscussionComment
+// getPullRequestDetailsNodeDiscussionPoll
+// getPullRequestDetailsNodeDiscussionPollOption
+// getPullRequestDetailsNodeDraftIssue
+// getPullRequestDetailsNodeEnterprise
+// getPullRequestDetailsNodeEnterpriseAdministratorInvitation
+// getPullRequestDetailsNodeEnterpriseIdentityProvider
+// getPullRequestDetailsNodeEnterpriseRepositoryInfo
+// getPullRequestDetailsNodeEnterpriseServerInstallation
+// getPullRequestDetailsNodeEnterpriseServerUserAccount
+// getPullRequestDetailsNodeEnterpriseServerUserAccountEmail
+// getPullRequestDetailsNodeEnterpriseServerUserAccountsUpload
+// getPullRequestDetailsNodeEnterpriseUserAccount
+// getPullRequestDetailsNodeEnvironment
+// getPullRequestDetailsNodeExternalIdentity
+// getPullRequestDetailsNodeGist
+// getPullRequestDetailsNodeGistComment
+// getPullRequestDetailsNodeHeadRefDeletedEvent
+// getPullRequestDetailsNodeHeadRefForcePushedEvent
+// getPullRequestDetailsNodeHeadRefRestoredEvent
+// getPullRequestDetailsNodeIpAllowListEntry
+// getPullRequestDetailsNodeIssue
+// getPullRequestDetailsNodeIssueComment
+// getPullRequestDetailsNodeLabel
+// getPullRequestDetailsNodeLabeledEvent
+// getPullRequestDetailsNodeLanguage
+// getPullRequestDetailsNodeLicense
+// getPullRequestDetailsNodeLinkedBranch
+// getPullRequestDetailsNodeLockedEvent
+// getPullRequestDetailsNodeMannequin
+// getPullRequestDetailsNodeMarkedAsDuplicateEvent
+// getPullRequestDetailsNodeMarketplaceCategory
+// getPullRequestDetailsNodeMarketplaceListing
+// getPullRequestDetailsNodeMembersCanDeleteReposClearAuditEntry
+// getPullRequestDetailsNodeMembersCanDeleteReposDisableAuditEntry
+// getPullRequestDetailsNodeMembersCanDeleteReposEnableAuditEntry
+// getPullRequestDetailsNodeMentionedEvent
+// getPullRequestDetailsNodeMergedEvent
+// getPullRequestDetailsNodeMigrationSource
+// getPullRequestDetailsNodeMilestone
+// getPullRequestDetailsNodeMilestonedEvent
+// getPullRequestDetailsNodeMovedColumnsInProjectEvent
+// getPullRequestDetailsNodeOIDCProvider
+// getPullRequestDetailsNodeOauthApplicationCreateAuditEntry
+// getPullRequestDetailsNodeOrgAddBillingManagerAuditEntry
+// getPullRequestDetailsNodeOrgAddMemberAuditEntry
+// getPullRequestDetailsNodeOrgBlockUserAuditEntry
+// getPullRequestDetailsNodeOrgConfigDisableCollaboratorsOnlyAuditEntry
+// getPullRequestDetailsNodeOrgConfigEnableCollaboratorsOnlyAuditEntry
+// getPullRequestDetailsNodeOrgCreateAuditEntry
+// getPullRequestDetailsNodeOrgDisableOauthAppRestrictionsAuditEntry
+// getPullRequestDetailsNodeOrgDisableSamlAuditEntry
+// getPullRequestDetailsNodeOrgDisableTwoFactorRequirementAuditEntry
+// getPullRequestDetailsNodeOrgEnableOauthAppRestrictionsAuditEntry
+// getPullRequestDetailsNodeOrgEnableSamlAuditEntry
+// getPullRequestDetailsNodeOrgEnableTwoFactorRequirementAuditEntry
+// getPullRequestDetailsNodeOrgInviteMemberAuditEntry
+// getPullRequestDetailsNodeOrgInviteToBusinessAuditEntry
+// getPullRequestDetailsNodeOrgOauthAppAccessApprovedAuditEntry
+// getPullRequestDetailsNodeOrgOauthAppAccessDeniedAuditEntry
+// getPullRequestDetailsNodeOrgOauthAppAccessRequestedAuditEntry
+// getPullRequestDetailsNodeOrgRemoveBillingManagerAuditEntry
+// getPullRequestDetailsNodeOrgRemoveMemberAuditEntry
+// getPullRequestDetailsNodeOrgRemoveOutsideCollaboratorAuditEntry
+// getPullRequestDetailsNodeOrgRestoreMemberAuditEntry
+// getPullRequestDetailsNodeOrgUnblockUserAuditEntry
+// getPullRequestDetailsNodeOrgUpdateDefaultRepositoryPermissionAuditEntry
+// getPullRequestDetailsNodeOrgUpdateMemberAuditEntry
+// getPullRequestDetailsNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry
+// getPullRequestDetailsNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry
+// getPullRequestDetailsNodeOrganization
+// getPullRequestDetailsNodeOrganizationIdentityProvider
+// getPullRequestDetailsNodeOrganizationInvitation
+// getPullRequestDetailsNodeOrganizationMigration
+// getPullRequestDetailsNodePackage
+// getPullRequestDetailsNodePackageFile
+// getPullRequestDetailsNodePackageTag
+// getPullRequestDetailsNodePackageVersion
+// getPullRequestDetailsNodePinnedDiscussion
+// getPullRequestDetailsNodePinnedEvent
+// getPullRequestDetailsNodePinnedIssue
+// getPullRequestDetailsNodePrivateRepositoryForkingDisableAuditEntry
+// getPullRequestDetailsNodePrivateRepositoryForkingEnableAuditEntry
+// getPullRequestDetailsNodeProject
+// getPullRequestDetailsNodeProjectCard
+// getPullRequestDetailsNodeProjectColumn
+// getPullRequestDetailsNodeProjectV2
+// getPullRequestDetailsNodeProjectV2Field
+// getPullRequestDetailsNodeProjectV2Item
+// getPullRequestDetailsNodeProjectV2ItemFieldDateValue
+// getPullRequestDetailsNodeProjectV2ItemFieldIterationValue
+// getPullRequestDetailsNodeProjectV2ItemFieldNumberValue
+// getPullRequestDetailsNodeProjectV2ItemFieldSingleSelectValue
+// getPullRequestDetailsNodeProjectV2ItemFieldTextValue
+// getPullRequestDetailsNodeProjectV2IterationField
+// getPullRequestDetailsNodeProjectV2SingleSelectField
+// getPullRequestDetailsNodeProjectV2View
+// getPullRequestDetailsNodeProjectV2Workflow
+// getPullRequestDetailsNodePublicKey
+// getPullRequestDetailsNodePullRequest
+// getPullRequestDetailsNodePullRequestCommit
+// getPullRequestDetailsNodePullRequestCommitCommentThread
+// getPullRequestDetailsNodePullRequestReview
+// getPullRequestDetailsNodePullRequestReviewComment
+// getPullRequestDetailsNodePullRequestReviewThread
+// getPullRequestDetailsNodePullRequestThread
+// getPullRequestDetailsNodePush
+// getPullRequestDetailsNodePushAllowance
+// getPullRequestDetailsNodeReaction
+// getPullRequestDetailsNodeReadyForReviewEvent
+// getPullRequestDetailsNodeRef
+// getPullRequestDetailsNodeReferencedEvent
+// getPullRequestDetailsNodeRelease
+// getPullRequestDetailsNodeReleaseAsset
+// getPullRequestDetailsNodeRemovedFromProjectEvent
+// getPullRequestDetailsNodeRenamedTitleEvent
+// getPullRequestDetailsNodeReopenedEvent
+// getPullRequestDetailsNodeRepoAccessAuditEntry
+// getPullRequestDetailsNodeRepoAddMemberAuditEntry
+// getPullRequestDetailsNodeRepoAddTopicAuditEntry
+// getPullRequestDetailsNodeRepoArchivedAuditEntry
+// getPullRequestDetailsNodeRepoChangeMergeSettingAuditEntry
+// getPullRequestDetailsNodeRepoConfigDisableAnonymousGitAccessAuditEntry
+// getPullRequestDetailsNodeRepoConfigDisableCollaboratorsOnlyAuditEntry
+// getPullRequestDetailsNodeRepoConfigDisableContributorsOnlyAuditEntry
+// getPullRequestDetailsNodeRepoConfigDisableSockpuppetDisallowedAuditEntry
+// getPullRequestDetailsNodeRepoConfigEnableAnonymousGitAccessAuditEntry
+// getPullRequestDetailsNodeRepoConfigEnableCollaboratorsOnlyAuditEntry
+// getPullRequestDetailsNodeRepoConfigEnableContributorsOnlyAuditEntry
+// getPullRequestDetailsNodeRepoConfigEnableSockpuppetDisallowedAuditEntry
+// getPullRequestDetailsNodeRepoConfigLockAnonymousGitAccessAuditEntry
+// getPullRequestDetailsNodeRepoConfigUnlockAnonymousGitAccessAuditEntry
+// getPullRequestDetailsNodeRepoCreateAuditEntry
+// getPullRequestDetailsNodeRepoDestroyAuditEntry
+// getPullRequestDetailsNodeRepoRemoveMemberAuditEntry
+// getPullRequestDetailsNodeRepoRemoveTopicAuditEntry
+// getPullRequestDetailsNodeRepository
+// getPullRequestDetailsNodeRepositoryInvitation
+// getPullRequestDetailsNodeRepositoryMigration
+// getPullRequestDetailsNodeRepositoryTopic
+// getPullRequestDetailsNodeRepositoryVisibilityChangeDisableAuditEntry
+// getPullRequestDetailsNodeRepositoryVisibilityChangeEnableAuditEntry
+// getPullRequestDetailsNodeRepositoryVulnerabilityAlert
+// getPullRequestDetailsNodeReviewDismissalAllowance
+// getPullRequestDetailsNodeReviewDismissedEvent
+// getPullRequestDetailsNodeReviewRequest
+// getPullRequestDetailsNodeReviewRequestRemovedEvent
+// getPullRequestDetailsNodeReviewRequestedEvent
+// getPullRequestDetailsNodeSavedReply
+// getPullRequestDetailsNodeSecurityAdvisory
+// getPullRequestDetailsNodeSponsorsActivity
+// getPullRequestDetailsNodeSponsorsListing
+// getPullRequestDetailsNodeSponsorsListingFeaturedItem
+// getPullRequestDetailsNodeSponsorsTier
+// getPullRequestDetailsNodeSponsorship
+// getPullRequestDetailsNodeSponsorshipNewsletter
+// getPullRequestDetailsNodeStatus
+// getPullRequestDetailsNodeStatusCheckRollup
+// getPullRequestDetailsNodeStatusContext
+// getPullRequestDetailsNodeSubscribedEvent
+// getPullRequestDetailsNodeTag
+// getPullRequestDetailsNodeTeam
+// getPullRequestDetailsNodeTeamAddMemberAuditEntry
+// getPullRequestDetailsNodeTeamAddRepositoryAuditEntry
+// getPullRequestDetailsNodeTeamChangeParentTeamAuditEntry
+// getPullRequestDetailsNodeTeamDiscussion
+// getPullRequestDetailsNodeTeamDiscussionComment
+// getPullRequestDetailsNodeTeamRemoveMemberAuditEntry
+// getPullRequestDetailsNodeTeamRemoveRepositoryAuditEntry
+// getPullRequestDetailsNodeTopic
+// getPullRequestDetailsNodeTransferredEvent
+// getPullRequestDetailsNodeTree
+// getPullRequestDetailsNodeUnassignedEvent
+// getPullRequestDetailsNodeUnlabeledEvent
+// getPullRequestDetailsNodeUnlockedEvent
+// getPullRequestDetailsNodeUnmarkedAsDuplicateEvent
+// getPullRequestDetailsNodeUnpinnedEvent
+// getPullRequestDetailsNodeUnsubscribedEvent
+// getPullRequestDetailsNodeUser
+// getPullRequestDetailsNodeUserBlockedEvent
+// getPullRequestDetailsNodeUserContentEdit
+// getPullRequestDetailsNodeUserStatus
+// getPullRequestDetailsNodeVerifiableDomain
+// getPullRequestDetailsNodeWorkflow
+// getPullRequestDetailsNodeWorkflowRun
+// The GraphQL type's documentation follows.
+//
+// An object with an ID.
+type getPullRequestDetailsNode interface {
+	implementsGraphQLInterfacegetPullRequestDetailsNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getPullRequestDetailsNodeAddedToProjectEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeApp) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeAssignedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeAutoMergeDisabledEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeAutoMergeEnabledEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeAutoRebaseEnabledEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeAutoSquashEnabledEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeAutomaticBaseChangeFailedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeAutomaticBaseChangeSucceededEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeBaseRefChangedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeBaseRefDeletedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeBaseRefForcePushedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeBlob) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeBot) implementsGraphQLInterfacegetPullRequestDetailsNode()  {}
+func (v *getPullRequestDetailsNodeBranchProtectionRule) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeBypassForcePushAllowance) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeBypassPullRequestAllowance) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeCWE) implementsGraphQLInterfacegetPullRequestDetailsNode()        {}
+func (v *getPullRequestDetailsNodeCheckRun) implementsGraphQLInterfacegetPullRequestDetailsNode()   {}
+func (v *getPullRequestDetailsNodeCheckSuite) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeClosedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeCodeOfConduct) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeCommentDeletedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeCommit) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeCommitComment) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeCommitCommentThread) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeComparison) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeConnectedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeConvertToDraftEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeConvertedNoteToIssueEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeConvertedToDiscussionEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeCrossReferencedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDemilestonedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDependencyGraphManifest) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDeployKey) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeDeployedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDeployment) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeDeploymentEnvironmentChangedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDeploymentReview) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDeploymentStatus) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDisconnectedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDiscussion) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeDiscussionCategory) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDiscussionComment) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDiscussionPoll) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDiscussionPollOption) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeDraftIssue) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeEnterprise) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeEnterpriseAdministratorInvitation) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeEnterpriseIdentityProvider) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeEnterpriseRepositoryInfo) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeEnterpriseServerInstallation) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeEnterpriseServerUserAccount) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeEnterpriseServerUserAccountEmail) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeEnterpriseServerUserAccountsUpload) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeEnterpriseUserAccount) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeEnvironment) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeExternalIdentity) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeGist) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeGistComment) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeHeadRefDeletedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeHeadRefForcePushedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeHeadRefRestoredEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeIpAllowListEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeIssue) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeIssueComment) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeLabel) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeLabeledEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeLanguage) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeLicense) implementsGraphQLInterfacegetPullRequestDetailsNode()  {}
+func (v *getPullRequestDetailsNodeLinkedBranch) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeLockedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMannequin) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeMarkedAsDuplicateEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMarketplaceCategory) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMarketplaceListing) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMembersCanDeleteReposClearAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMembersCanDeleteReposDisableAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMembersCanDeleteReposEnableAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMentionedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMergedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMigrationSource) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMilestone) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeMilestonedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeMovedColumnsInProjectEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOIDCProvider) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOauthApplicationCreateAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgAddBillingManagerAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgAddMemberAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgBlockUserAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgConfigDisableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgConfigEnableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgCreateAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgDisableOauthAppRestrictionsAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgDisableSamlAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgDisableTwoFactorRequirementAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgEnableOauthAppRestrictionsAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgEnableSamlAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgEnableTwoFactorRequirementAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgInviteMemberAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgInviteToBusinessAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgOauthAppAccessApprovedAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgOauthAppAccessDeniedAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgOauthAppAccessRequestedAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgRemoveBillingManagerAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgRemoveMemberAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgRemoveOutsideCollaboratorAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgRestoreMemberAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgUnblockUserAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgUpdateDefaultRepositoryPermissionAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgUpdateMemberAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrganization) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrganizationIdentityProvider) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrganizationInvitation) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeOrganizationMigration) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePackage) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodePackageFile) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePackageTag) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodePackageVersion) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePinnedDiscussion) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePinnedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePinnedIssue) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePrivateRepositoryForkingDisableAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePrivateRepositoryForkingEnableAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProject) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeProjectCard) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectColumn) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeProjectV2Field) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2Item) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldDateValue) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldIterationValue) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldNumberValue) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldSingleSelectValue) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldTextValue) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2IterationField) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2SingleSelectField) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2View) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeProjectV2Workflow) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePublicKey) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodePullRequest) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePullRequestCommit) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePullRequestCommitCommentThread) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePullRequestReview) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePullRequestReviewComment) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePullRequestReviewThread) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePullRequestThread) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodePush) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodePushAllowance) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeReaction) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeReadyForReviewEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRef) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeReferencedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRelease) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeReleaseAsset) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRemovedFromProjectEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRenamedTitleEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeReopenedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoAccessAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoAddMemberAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoAddTopicAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoArchivedAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoChangeMergeSettingAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigDisableAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigDisableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigDisableContributorsOnlyAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigDisableSockpuppetDisallowedAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigEnableAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigEnableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigEnableContributorsOnlyAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigEnableSockpuppetDisallowedAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigLockAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoConfigUnlockAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoCreateAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoDestroyAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoRemoveMemberAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepoRemoveTopicAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepository) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeRepositoryInvitation) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepositoryMigration) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepositoryTopic) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepositoryVisibilityChangeDisableAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepositoryVisibilityChangeEnableAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeRepositoryVulnerabilityAlert) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeReviewDismissalAllowance) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeReviewDismissedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeReviewRequest) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeReviewRequestRemovedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeReviewRequestedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeSavedReply) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeSecurityAdvisory) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeSponsorsActivity) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeSponsorsListing) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeSponsorsListingFeaturedItem) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeSponsorsTier) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeSponsorship) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeSponsorshipNewsletter) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeStatus) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeStatusCheckRollup) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeStatusContext) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeSubscribedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeTag) implementsGraphQLInterfacegetPullRequestDetailsNode()  {}
+func (v *getPullRequestDetailsNodeTeam) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeTeamAddMemberAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeTeamAddRepositoryAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeTeamChangeParentTeamAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeTeamDiscussion) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeTeamDiscussionComment) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeTeamRemoveMemberAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeTeamRemoveRepositoryAuditEntry) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeTopic) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeTransferredEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeTree) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeUnassignedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeUnlabeledEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeUnlockedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeUnmarkedAsDuplicateEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeUnpinnedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeUnsubscribedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeUser) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeUserBlockedEvent) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeUserContentEdit) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeUserStatus) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeVerifiableDomain) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+func (v *getPullRequestDetailsNodeWorkflow) implementsGraphQLInterfacegetPullRequestDetailsNode() {}
+func (v *getPullRequestDetailsNodeWorkflowRun) implementsGraphQLInterfacegetPullRequestDetailsNode() {
+}
+
+func __unmarshalgetPullRequestDetailsNode(b []byte, v *getPullRequestDetailsNode) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AddedToProjectEvent":
+		*v = new(getPullRequestDetailsNodeAddedToProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "App":
+		*v = new(getPullRequestDetailsNodeApp)
+		return json.Unmarshal(b, *v)
+	case "AssignedEvent":
+		*v = new(getPullRequestDetailsNodeAssignedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeDisabledEvent":
+		*v = new(getPullRequestDetailsNodeAutoMergeDisabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeEnabledEvent":
+		*v = new(getPullRequestDetailsNodeAutoMergeEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoRebaseEnabledEvent":
+		*v = new(getPullRequestDetailsNodeAutoRebaseEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoSquashEnabledEvent":
+		*v = new(getPullRequestDetailsNodeAutoSquashEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeFailedEvent":
+		*v = new(getPullRequestDetailsNodeAutomaticBaseChangeFailedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeSucceededEvent":
+		*v = new(getPullRequestDetailsNodeAutomaticBaseChangeSucceededEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefChangedEvent":
+		*v = new(getPullRequestDetailsNodeBaseRefChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefDeletedEvent":
+		*v = new(getPullRequestDetailsNodeBaseRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefForcePushedEvent":
+		*v = new(getPullRequestDetailsNodeBaseRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "Blob":
+		*v = new(getPullRequestDetailsNodeBlob)
+		return json.Unmarshal(b, *v)
+	case "Bot":
+		*v = new(getPullRequestDetailsNodeBot)
+		return json.Unmarshal(b, *v)
+	case "BranchProtectionRule":
+		*v = new(getPullRequestDetailsNodeBranchProtectionRule)
+		return json.Unmarshal(b, *v)
+	case "BypassForcePushAllowance":
+		*v = new(getPullRequestDetailsNodeBypassForcePushAllowance)
+		return json.Unmarshal(b, *v)
+	case "BypassPullRequestAllowance":
+		*v = new(getPullRequestDetailsNodeBypassPullRequestAllowance)
+		return json.Unmarshal(b, *v)
+	case "CWE":
+		*v = new(getPullRequestDetailsNodeCWE)
+		return json.Unmarshal(b, *v)
+	case "CheckRun":
+		*v = new(getPullRequestDetailsNodeCheckRun)
+		return json.Unmarshal(b, *v)
+	case "CheckSuite":
+		*v = new(getPullRequestDetailsNodeCheckSuite)
+		return json.Unmarshal(b, *v)
+	case "ClosedEvent":
+		*v = new(getPullRequestDetailsNodeClosedEvent)
+		return json.Unmarshal(b, *v)
+	case "CodeOfConduct":
+		*v = new(getPullRequestDetailsNodeCodeOfConduct)
+		return json.Unmarshal(b, *v)
+	case "CommentDeletedEvent":
+		*v = new(getPullRequestDetailsNodeCommentDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getPullRequestDetailsNodeCommit)
+		return json.Unmarshal(b, *v)
+	case "CommitComment":
+		*v = new(getPullRequestDetailsNodeCommitComment)
+		return json.Unmarshal(b, *v)
+	case "CommitCommentThread":
+		*v = new(getPullRequestDetailsNodeCommitCommentThread)
+		return json.Unmarshal(b, *v)
+	case "Comparison":
+		*v = new(getPullRequestDetailsNodeComparison)
+		return json.Unmarshal(b, *v)
+	case "ConnectedEvent":
+		*v = new(getPullRequestDetailsNodeConnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertToDraftEvent":
+		*v = new(getPullRequestDetailsNodeConvertToDraftEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedNoteToIssueEvent":
+		*v = new(getPullRequestDetailsNodeConvertedNoteToIssueEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedToDiscussionEvent":
+		*v = new(getPullRequestDetailsNodeConvertedToDiscussionEvent)
+		return json.Unmarshal(b, *v)
+	case "CrossReferencedEvent":
+		*v = new(getPullRequestDetailsNodeCrossReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "DemilestonedEvent":
+		*v = new(getPullRequestDetailsNodeDemilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "DependencyGraphManifest":
+		*v = new(getPullRequestDetailsNodeDependencyGraphManifest)
+		return json.Unmarshal(b, *v)
+	case "DeployKey":
+		*v = new(getPullRequestDetailsNodeDeployKey)
+		return json.Unmarshal(b, *v)
+	case "DeployedEvent":
+		*v = new(getPullRequestDetailsNodeDeployedEvent)
+		return json.Unmarshal(b, *v)
+	case "Deployment":
+		*v = new(getPullRequestDetailsNodeDeployment)
+		return json.Unmarshal(b, *v)
+	case "DeploymentEnvironmentChangedEvent":
+		*v = new(getPullRequestDetailsNodeDeploymentEnvironmentChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "DeploymentReview":
+		*v = new(getPullRequestDetailsNodeDeploymentReview)
+		return json.Unmarshal(b, *v)
+	case "DeploymentStatus":
+		*v = new(getPullRequestDetailsNodeDeploymentStatus)
+		return json.Unmarshal(b, *v)
+	case "DisconnectedEvent":
+		*v = new(getPullRequestDetailsNodeDisconnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "Discussion":
+		*v = new(getPullRequestDetailsNodeDiscussion)
+		return json.Unmarshal(b, *v)
+	case "DiscussionCategory":
+		*v = new(getPullRequestDetailsNodeDiscussionCategory)
+		return json.Unmarshal(b, *v)
+	case "DiscussionComment":
+		*v = new(getPullRequestDetailsNodeDiscussionComment)
+		return json.Unmarshal(b, *v)
+	case "DiscussionPoll":
+		*v = new(getPullRequestDetailsNodeDiscussionPoll)
+		return json.Unmarshal(b, *v)
+	case "DiscussionPollOption":
+		*v = new(getPullRequestDetailsNodeDiscussionPollOption)
+		return json.Unmarshal(b, *v)
+	case "DraftIssue":
+		*v = new(getPullRequestDetailsNodeDraftIssue)
+		return json.Unmarshal(b, *v)
+	case "Enterprise":
+		*v = new(getPullRequestDetailsNodeEnterprise)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseAdministratorInvitation":
+		*v = new(getPullRequestDetailsNodeEnterpriseAdministratorInvitation)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseIdentityProvider":
+		*v = new(getPullRequestDetailsNodeEnterpriseIdentityProvider)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseRepositoryInfo":
+		*v = new(getPullRequestDetailsNodeEnterpriseRepositoryInfo)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerInstallation":
+		*v = new(getPullRequestDetailsNodeEnterpriseServerInstallation)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccount":
+		*v = new(getPullRequestDetailsNodeEnterpriseServerUserAccount)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccountEmail":
+		*v = new(getPullRequestDetailsNodeEnterpriseServerUserAccountEmail)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccountsUpload":
+		*v = new(getPullRequestDetailsNodeEnterpriseServerUserAccountsUpload)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseUserAccount":
+		*v = new(getPullRequestDetailsNodeEnterpriseUserAccount)
+		return json.Unmarshal(b, *v)
+	case "Environment":
+		*v = new(getPullRequestDetailsNodeEnvironment)
+		return json.Unmarshal(b, *v)
+	case "ExternalIdentity":
+		*v = new(getPullRequestDetailsNodeExternalIdentity)
+		return json.Unmarshal(b, *v)
+	case "Gist":
+		*v = new(getPullRequestDetailsNodeGist)
+		return json.Unmarshal(b, *v)
+	case "GistComment":
+		*v = new(getPullRequestDetailsNodeGistComment)
+		return json.Unmarshal(b, *v)
+	case "HeadRefDeletedEvent":
+		*v = new(getPullRequestDetailsNodeHeadRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefForcePushedEvent":
+		*v = new(getPullRequestDetailsNodeHeadRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefRestoredEvent":
+		*v = new(getPullRequestDetailsNodeHeadRefRestoredEvent)
+		return json.Unmarshal(b, *v)
+	case "IpAllowListEntry":
+		*v = new(getPullRequestDetailsNodeIpAllowListEntry)
+		return json.Unmarshal(b, *v)
+	case "Issue":
+		*v = new(getPullRequestDetailsNodeIssue)
+		return json.Unmarshal(b, *v)
+	case "IssueComment":
+		*v = new(getPullRequestDetailsNodeIssueComment)
+		return json.Unmarshal(b, *v)
+	case "Label":
+		*v = new(getPullRequestDetailsNodeLabel)
+		return json.Unmarshal(b, *v)
+	case "LabeledEvent":
+		*v = new(getPullRequestDetailsNodeLabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "Language":
+		*v = new(getPullRequestDetailsNodeLanguage)
+		return json.Unmarshal(b, *v)
+	case "License":
+		*v = new(getPullRequestDetailsNodeLicense)
+		return json.Unmarshal(b, *v)
+	case "LinkedBranch":
+		*v = new(getPullRequestDetailsNodeLinkedBranch)
+		return json.Unmarshal(b, *v)
+	case "LockedEvent":
+		*v = new(getPullRequestDetailsNodeLockedEvent)
+		return json.Unmarshal(b, *v)
+	case "Mannequin":
+		*v = new(getPullRequestDetailsNodeMannequin)
+		return json.Unmarshal(b, *v)
+	case "MarkedAsDuplicateEvent":
+		*v = new(getPullRequestDetailsNodeMarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceCategory":
+		*v = new(getPullRequestDetailsNodeMarketplaceCategory)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceListing":
+		*v = new(getPullRequestDetailsNodeMarketplaceListing)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposClearAuditEntry":
+		*v = new(getPullRequestDetailsNodeMembersCanDeleteReposClearAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposDisableAuditEntry":
+		*v = new(getPullRequestDetailsNodeMembersCanDeleteReposDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposEnableAuditEntry":
+		*v = new(getPullRequestDetailsNodeMembersCanDeleteReposEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MentionedEvent":
+		*v = new(getPullRequestDetailsNodeMentionedEvent)
+		return json.Unmarshal(b, *v)
+	case "MergedEvent":
+		*v = new(getPullRequestDetailsNodeMergedEvent)
+		return json.Unmarshal(b, *v)
+	case "MigrationSource":
+		*v = new(getPullRequestDetailsNodeMigrationSource)
+		return json.Unmarshal(b, *v)
+	case "Milestone":
+		*v = new(getPullRequestDetailsNodeMilestone)
+		return json.Unmarshal(b, *v)
+	case "MilestonedEvent":
+		*v = new(getPullRequestDetailsNodeMilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "MovedColumnsInProjectEvent":
+		*v = new(getPullRequestDetailsNodeMovedColumnsInProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "OIDCProvider":
+		*v = new(getPullRequestDetailsNodeOIDCProvider)
+		return json.Unmarshal(b, *v)
+	case "OauthApplicationCreateAuditEntry":
+		*v = new(getPullRequestDetailsNodeOauthApplicationCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgAddBillingManagerAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgAddBillingManagerAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgAddMemberAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgBlockUserAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgBlockUserAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgConfigDisableCollaboratorsOnlyAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgConfigDisableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgConfigEnableCollaboratorsOnlyAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgConfigEnableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgCreateAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableOauthAppRestrictionsAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgDisableOauthAppRestrictionsAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableSamlAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgDisableSamlAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableTwoFactorRequirementAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgDisableTwoFactorRequirementAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableOauthAppRestrictionsAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgEnableOauthAppRestrictionsAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableSamlAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgEnableSamlAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableTwoFactorRequirementAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgEnableTwoFactorRequirementAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgInviteMemberAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgInviteMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgInviteToBusinessAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgInviteToBusinessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessApprovedAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgOauthAppAccessApprovedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessDeniedAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgOauthAppAccessDeniedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessRequestedAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgOauthAppAccessRequestedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveBillingManagerAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgRemoveBillingManagerAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveMemberAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveOutsideCollaboratorAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgRemoveOutsideCollaboratorAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRestoreMemberAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgRestoreMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUnblockUserAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgUnblockUserAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateDefaultRepositoryPermissionAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgUpdateDefaultRepositoryPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgUpdateMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberRepositoryCreationPermissionAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberRepositoryInvitationPermissionAuditEntry":
+		*v = new(getPullRequestDetailsNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(getPullRequestDetailsNodeOrganization)
+		return json.Unmarshal(b, *v)
+	case "OrganizationIdentityProvider":
+		*v = new(getPullRequestDetailsNodeOrganizationIdentityProvider)
+		return json.Unmarshal(b, *v)
+	case "OrganizationInvitation":
+		*v = new(getPullRequestDetailsNodeOrganizationInvitation)
+		return json.Unmarshal(b, *v)
+	case "OrganizationMigration":
+		*v = new(getPullRequestDetailsNodeOrganizationMigration)
+		return json.Unmarshal(b, *v)
+	case "Package":
+		*v = new(getPullRequestDetailsNodePackage)
+		return json.Unmarshal(b, *v)
+	case "PackageFile":
+		*v = new(getPullRequestDetailsNodePackageFile)
+		return json.Unmarshal(b, *v)
+	case "PackageTag":
+		*v = new(getPullRequestDetailsNodePackageTag)
+		return json.Unmarshal(b, *v)
+	case "PackageVersion":
+		*v = new(getPullRequestDetailsNodePackageVersion)
+		return json.Unmarshal(b, *v)
+	case "PinnedDiscussion":
+		*v = new(getPullRequestDetailsNodePinnedDiscussion)
+		return json.Unmarshal(b, *v)
+	case "PinnedEvent":
+		*v = new(getPullRequestDetailsNodePinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "PinnedIssue":
+		*v = new(getPullRequestDetailsNodePinnedIssue)
+		return json.Unmarshal(b, *v)
+	case "PrivateRepositoryForkingDisableAuditEntry":
+		*v = new(getPullRequestDetailsNodePrivateRepositoryForkingDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "PrivateRepositoryForkingEnableAuditEntry":
+		*v = new(getPullRequestDetailsNodePrivateRepositoryForkingEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Project":
+		*v = new(getPullRequestDetailsNodeProject)
+		return json.Unmarshal(b, *v)
+	case "ProjectCard":
+		*v = new(getPullRequestDetailsNodeProjectCard)
+		return json.Unmarshal(b, *v)
+	case "ProjectColumn":
+		*v = new(getPullRequestDetailsNodeProjectColumn)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2":
+		*v = new(getPullRequestDetailsNodeProjectV2)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Field":
+		*v = new(getPullRequestDetailsNodeProjectV2Field)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Item":
+		*v = new(getPullRequestDetailsNodeProjectV2Item)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldDateValue":
+		*v = new(getPullRequestDetailsNodeProjectV2ItemFieldDateValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldIterationValue":
+		*v = new(getPullRequestDetailsNodeProjectV2ItemFieldIterationValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldNumberValue":
+		*v = new(getPullRequestDetailsNodeProjectV2ItemFieldNumberValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldSingleSelectValue":
+		*v = new(getPullRequestDetailsNodeProjectV2ItemFieldSingleSelectValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldTextValue":
+		*v = new(getPullRequestDetailsNodeProjectV2ItemFieldTextValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2IterationField":
+		*v = new(getPullRequestDetailsNodeProjectV2IterationField)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2SingleSelectField":
+		*v = new(getPullRequestDetailsNodeProjectV2SingleSelectField)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2View":
+		*v = new(getPullRequestDetailsNodeProjectV2View)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Workflow":
+		*v = new(getPullRequestDetailsNodeProjectV2Workflow)
+		return json.Unmarshal(b, *v)
+	case "PublicKey":
+		*v = new(getPullRequestDetailsNodePublicKey)
+		return json.Unmarshal(b, *v)
+	case "PullRequest":
+		*v = new(getPullRequestDetailsNodePullRequest)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommit":
+		*v = new(getPullRequestDetailsNodePullRequestCommit)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommitCommentThread":
+		*v = new(getPullRequestDetailsNodePullRequestCommitCommentThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReview":
+		*v = new(getPullRequestDetailsNodePullRequestReview)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReviewComment":
+		*v = new(getPullRequestDetailsNodePullRequestReviewComment)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReviewThread":
+		*v = new(getPullRequestDetailsNodePullRequestReviewThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestThread":
+		*v = new(getPullRequestDetailsNodePullRequestThread)
+		return json.Unmarshal(b, *v)
+	case "Push":
+		*v = new(getPullRequestDetailsNodePush)
+		return json.Unmarshal(b, *v)
+	case "PushAllowance":
+		*v = new(getPullRequestDetailsNodePushAllowance)
+		return json.Unmarshal(b, *v)
+	case "Reaction":
+		*v = new(getPullRequestDetailsNodeReaction)
+		return json.Unmarshal(b, *v)
+	case "ReadyForReviewEvent":
+		*v = new(getPullRequestDetailsNodeReadyForReviewEvent)
+		return json.Unmarshal(b, *v)
+	case "Ref":
+		*v = new(getPullRequestDetailsNodeRef)
+		return json.Unmarshal(b, *v)
+	case "ReferencedEvent":
+		*v = new(getPullRequestDetailsNodeReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "Release":
+		*v = new(getPullRequestDetailsNodeRelease)
+		return json.Unmarshal(b, *v)
+	case "ReleaseAsset":
+		*v = new(getPullRequestDetailsNodeReleaseAsset)
+		return json.Unmarshal(b, *v)
+	case "RemovedFromProjectEvent":
+		*v = new(getPullRequestDetailsNodeRemovedFromProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "RenamedTitleEvent":
+		*v = new(getPullRequestDetailsNodeRenamedTitleEvent)
+		return json.Unmarshal(b, *v)
+	case "ReopenedEvent":
+		*v = new(getPullRequestDetailsNodeReopenedEvent)
+		return json.Unmarshal(b, *v)
+	case "RepoAccessAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoAddMemberAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoAddTopicAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoAddTopicAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoArchivedAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoArchivedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoChangeMergeSettingAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoChangeMergeSettingAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableAnonymousGitAccessAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigDisableAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableCollaboratorsOnlyAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigDisableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableContributorsOnlyAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigDisableContributorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableSockpuppetDisallowedAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigDisableSockpuppetDisallowedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableAnonymousGitAccessAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigEnableAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableCollaboratorsOnlyAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigEnableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableContributorsOnlyAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigEnableContributorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableSockpuppetDisallowedAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigEnableSockpuppetDisallowedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigLockAnonymousGitAccessAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigLockAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigUnlockAnonymousGitAccessAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoConfigUnlockAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoCreateAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoDestroyAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoDestroyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoRemoveMemberAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoRemoveTopicAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepoRemoveTopicAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Repository":
+		*v = new(getPullRequestDetailsNodeRepository)
+		return json.Unmarshal(b, *v)
+	case "RepositoryInvitation":
+		*v = new(getPullRequestDetailsNodeRepositoryInvitation)
+		return json.Unmarshal(b, *v)
+	case "RepositoryMigration":
+		*v = new(getPullRequestDetailsNodeRepositoryMigration)
+		return json.Unmarshal(b, *v)
+	case "RepositoryTopic":
+		*v = new(getPullRequestDetailsNodeRepositoryTopic)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVisibilityChangeDisableAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepositoryVisibilityChangeDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVisibilityChangeEnableAuditEntry":
+		*v = new(getPullRequestDetailsNodeRepositoryVisibilityChangeEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVulnerabilityAlert":
+		*v = new(getPullRequestDetailsNodeRepositoryVulnerabilityAlert)
+		return json.Unmarshal(b, *v)
+	case "ReviewDismissalAllowance":
+		*v = new(getPullRequestDetailsNodeReviewDismissalAllowance)
+		return json.Unmarshal(b, *v)
+	case "ReviewDismissedEvent":
+		*v = new(getPullRequestDetailsNodeReviewDismissedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequest":
+		*v = new(getPullRequestDetailsNodeReviewRequest)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestRemovedEvent":
+		*v = new(getPullRequestDetailsNodeReviewRequestRemovedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestedEvent":
+		*v = new(getPullRequestDetailsNodeReviewRequestedEvent)
+		return json.Unmarshal(b, *v)
+	case "SavedReply":
+		*v = new(getPullRequestDetailsNodeSavedReply)
+		return json.Unmarshal(b, *v)
+	case "SecurityAdvisory":
+		*v = new(getPullRequestDetailsNodeSecurityAdvisory)
+		return json.Unmarshal(b, *v)
+	case "SponsorsActivity":
+		*v = new(getPullRequestDetailsNodeSponsorsActivity)
+		return json.Unmarshal(b, *v)
+	case "SponsorsListing":
+		*v = new(getPullRequestDetailsNodeSponsorsListing)
+		return json.Unmarshal(b, *v)
+	case "SponsorsListingFeaturedItem":
+		*v = new(getPullRequestDetailsNodeSponsorsListingFeaturedItem)
+		return json.Unmarshal(b, *v)
+	case "SponsorsTier":
+		*v = new(getPullRequestDetailsNodeSponsorsTier)
+		return json.Unmarshal(b, *v)
+	case "Sponsorship":
+		*v = new(getPullRequestDetailsNodeSponsorship)
+		return json.Unmarshal(b, *v)
+	case "SponsorshipNewsletter":
+		*v = new(getPullRequestDetailsNodeSponsorshipNewsletter)
+		return json.Unmarshal(b, *v)
+	case "Status":
+		*v = new(getPullRequestDetailsNodeStatus)
+		return json.Unmarshal(b, *v)
+	case "StatusCheckRollup":
+		*v = new(getPullRequestDetailsNodeStatusCheckRollup)
+		return json.Unmarshal(b, *v)
+	case "StatusContext":
+		*v = new(getPullRequestDetailsNodeStatusContext)
+		return json.Unmarshal(b, *v)
+	case "SubscribedEvent":
+		*v = new(getPullRequestDetailsNodeSubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getPullRequestDetailsNodeTag)
+		return json.Unmarshal(b, *v)
+	case "Team":
+		*v = new(getPullRequestDetailsNodeTeam)
+		return json.Unmarshal(b, *v)
+	case "TeamAddMemberAuditEntry":
+		*v = new(getPullRequestDetailsNodeTeamAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamAddRepositoryAuditEntry":
+		*v = new(getPullRequestDetailsNodeTeamAddRepositoryAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamChangeParentTeamAuditEntry":
+		*v = new(getPullRequestDetailsNodeTeamChangeParentTeamAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamDiscussion":
+		*v = new(getPullRequestDetailsNodeTeamDiscussion)
+		return json.Unmarshal(b, *v)
+	case "TeamDiscussionComment":
+		*v = new(getPullRequestDetailsNodeTeamDiscussionComment)
+		return json.Unmarshal(b, *v)
+	case "TeamRemoveMemberAuditEntry":
+		*v = new(getPullRequestDetailsNodeTeamRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamRemoveRepositoryAuditEntry":
+		*v = new(getPullRequestDetailsNodeTeamRemoveRepositoryAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(getPullRequestDetailsNodeTopic)
+		return json.Unmarshal(b, *v)
+	case "TransferredEvent":
+		*v = new(getPullRequestDetailsNodeTransferredEvent)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getPullRequestDetailsNodeTree)
+		return json.Unmarshal(b, *v)
+	case "UnassignedEvent":
+		*v = new(getPullRequestDetailsNodeUnassignedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlabeledEvent":
+		*v = new(getPullRequestDetailsNodeUnlabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlockedEvent":
+		*v = new(getPullRequestDetailsNodeUnlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnmarkedAsDuplicateEvent":
+		*v = new(getPullRequestDetailsNodeUnmarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "UnpinnedEvent":
+		*v = new(getPullRequestDetailsNodeUnpinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnsubscribedEvent":
+		*v = new(getPullRequestDetailsNodeUnsubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(getPullRequestDetailsNodeUser)
+		return json.Unmarshal(b, *v)
+	case "UserBlockedEvent":
+		*v = new(getPullRequestDetailsNodeUserBlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UserContentEdit":
+		*v = new(getPullRequestDetailsNodeUserContentEdit)
+		return json.Unmarshal(b, *v)
+	case "UserStatus":
+		*v = new(getPullRequestDetailsNodeUserStatus)
+		return json.Unmarshal(b, *v)
+	case "VerifiableDomain":
+		*v = new(getPullRequestDetailsNodeVerifiableDomain)
+		return json.Unmarshal(b, *v)
+	case "Workflow":
+		*v = new(getPullRequestDetailsNodeWorkflow)
+		return json.Unmarshal(b, *v)
+	case "WorkflowRun":
+		*v = new(getPullRequestDetailsNodeWorkflowRun)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Node.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getPullRequestDetailsNode: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetPullRequestDetailsNode(v *getPullRequestDetailsNode) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getPullRequestDetailsNodeAddedToProjectEvent:
+		typename = "AddedToProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeAddedToProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeApp:
+		typename = "App"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeAssignedEvent:
+		typename = "AssignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeAssignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeAutoMergeDisabledEvent:
+		typename = "AutoMergeDisabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeAutoMergeDisabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeAutoMergeEnabledEvent:
+		typename = "AutoMergeEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeAutoMergeEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeAutoRebaseEnabledEvent:
+		typename = "AutoRebaseEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeAutoRebaseEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeAutoSquashEnabledEvent:
+		typename = "AutoSquashEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeAutoSquashEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeAutomaticBaseChangeFailedEvent:
+		typename = "AutomaticBaseChangeFailedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeAutomaticBaseChangeFailedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeAutomaticBaseChangeSucceededEvent:
+		typename = "AutomaticBaseChangeSucceededEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeAutomaticBaseChangeSucceededEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeBaseRefChangedEvent:
+		typename = "BaseRefChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeBaseRefChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeBaseRefDeletedEvent:
+		typename = "BaseRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeBaseRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeBaseRefForcePushedEvent:
+		typename = "BaseRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeBaseRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeBot:
+		typename = "Bot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeBot
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeBranchProtectionRule:
+		typename = "BranchProtectionRule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeBranchProtectionRule
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeBypassForcePushAllowance:
+		typename = "BypassForcePushAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeBypassForcePushAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeBypassPullRequestAllowance:
+		typename = "BypassPullRequestAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeBypassPullRequestAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeCWE:
+		typename = "CWE"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeCWE
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeCheckRun:
+		typename = "CheckRun"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeCheckRun
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeCheckSuite:
+		typename = "CheckSuite"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeCheckSuite
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeClosedEvent:
+		typename = "ClosedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeClosedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeCodeOfConduct:
+		typename = "CodeOfConduct"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeCodeOfConduct
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeCommentDeletedEvent:
+		typename = "CommentDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeCommentDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeCommitComment:
+		typename = "CommitComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeCommitComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeCommitCommentThread:
+		typename = "CommitCommentThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeCommitCommentThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeComparison:
+		typename = "Comparison"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeComparison
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeConnectedEvent:
+		typename = "ConnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeConnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeConvertToDraftEvent:
+		typename = "ConvertToDraftEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeConvertToDraftEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeConvertedNoteToIssueEvent:
+		typename = "ConvertedNoteToIssueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeConvertedNoteToIssueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeConvertedToDiscussionEvent:
+		typename = "ConvertedToDiscussionEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeConvertedToDiscussionEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeCrossReferencedEvent:
+		typename = "CrossReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeCrossReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDemilestonedEvent:
+		typename = "DemilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDemilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDependencyGraphManifest:
+		typename = "DependencyGraphManifest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDependencyGraphManifest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDeployKey:
+		typename = "DeployKey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDeployKey
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDeployedEvent:
+		typename = "DeployedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDeployedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDeployment:
+		typename = "Deployment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDeployment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDeploymentEnvironmentChangedEvent:
+		typename = "DeploymentEnvironmentChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDeploymentEnvironmentChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDeploymentReview:
+		typename = "DeploymentReview"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDeploymentReview
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDeploymentStatus:
+		typename = "DeploymentStatus"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDeploymentStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDisconnectedEvent:
+		typename = "DisconnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDisconnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDiscussion:
+		typename = "Discussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDiscussionCategory:
+		typename = "DiscussionCategory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDiscussionCategory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDiscussionComment:
+		typename = "DiscussionComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDiscussionComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDiscussionPoll:
+		typename = "DiscussionPoll"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDiscussionPoll
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDiscussionPollOption:
+		typename = "DiscussionPollOption"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDiscussionPollOption
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeDraftIssue:
+		typename = "DraftIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeDraftIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnterprise:
+		typename = "Enterprise"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnterprise
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnterpriseAdministratorInvitation:
+		typename = "EnterpriseAdministratorInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnterpriseAdministratorInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnterpriseIdentityProvider:
+		typename = "EnterpriseIdentityProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnterpriseIdentityProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnterpriseRepositoryInfo:
+		typename = "EnterpriseRepositoryInfo"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnterpriseRepositoryInfo
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnterpriseServerInstallation:
+		typename = "EnterpriseServerInstallation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnterpriseServerInstallation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnterpriseServerUserAccount:
+		typename = "EnterpriseServerUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnterpriseServerUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnterpriseServerUserAccountEmail:
+		typename = "EnterpriseServerUserAccountEmail"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnterpriseServerUserAccountEmail
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnterpriseServerUserAccountsUpload:
+		typename = "EnterpriseServerUserAccountsUpload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnterpriseServerUserAccountsUpload
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnterpriseUserAccount:
+		typename = "EnterpriseUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnterpriseUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeEnvironment:
+		typename = "Environment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeEnvironment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeExternalIdentity:
+		typename = "ExternalIdentity"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeExternalIdentity
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeGist:
+		typename = "Gist"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeGist
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeGistComment:
+		typename = "GistComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeGistComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeHeadRefDeletedEvent:
+		typename = "HeadRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeHeadRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeHeadRefForcePushedEvent:
+		typename = "HeadRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeHeadRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeHeadRefRestoredEvent:
+		typename = "HeadRefRestoredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeHeadRefRestoredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeIpAllowListEntry:
+		typename = "IpAllowListEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeIpAllowListEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeIssue:
+		typename = "Issue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeIssueComment:
+		typename = "IssueComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeIssueComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeLabel:
+		typename = "Label"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeLabel
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeLabeledEvent:
+		typename = "LabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeLabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeLanguage:
+		typename = "Language"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeLanguage
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeLicense:
+		typename = "License"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeLicense
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeLinkedBranch:
+		typename = "LinkedBranch"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeLinkedBranch
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeLockedEvent:
+		typename = "LockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeLockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMannequin:
+		typename = "Mannequin"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMannequin
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMarkedAsDuplicateEvent:
+		typename = "MarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMarketplaceCategory:
+		typename = "MarketplaceCategory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMarketplaceCategory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMarketplaceListing:
+		typename = "MarketplaceListing"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMarketplaceListing
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMembersCanDeleteReposClearAuditEntry:
+		typename = "MembersCanDeleteReposClearAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMembersCanDeleteReposClearAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMembersCanDeleteReposDisableAuditEntry:
+		typename = "MembersCanDeleteReposDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMembersCanDeleteReposDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMembersCanDeleteReposEnableAuditEntry:
+		typename = "MembersCanDeleteReposEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMembersCanDeleteReposEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMentionedEvent:
+		typename = "MentionedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMentionedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMergedEvent:
+		typename = "MergedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMergedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMigrationSource:
+		typename = "MigrationSource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMigrationSource
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMilestone:
+		typename = "Milestone"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMilestone
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMilestonedEvent:
+		typename = "MilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeMovedColumnsInProjectEvent:
+		typename = "MovedColumnsInProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeMovedColumnsInProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOIDCProvider:
+		typename = "OIDCProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOIDCProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOauthApplicationCreateAuditEntry:
+		typename = "OauthApplicationCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOauthApplicationCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgAddBillingManagerAuditEntry:
+		typename = "OrgAddBillingManagerAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgAddBillingManagerAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgAddMemberAuditEntry:
+		typename = "OrgAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgBlockUserAuditEntry:
+		typename = "OrgBlockUserAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgBlockUserAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgConfigDisableCollaboratorsOnlyAuditEntry:
+		typename = "OrgConfigDisableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgConfigDisableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgConfigEnableCollaboratorsOnlyAuditEntry:
+		typename = "OrgConfigEnableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgConfigEnableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgCreateAuditEntry:
+		typename = "OrgCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgDisableOauthAppRestrictionsAuditEntry:
+		typename = "OrgDisableOauthAppRestrictionsAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgDisableOauthAppRestrictionsAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgDisableSamlAuditEntry:
+		typename = "OrgDisableSamlAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgDisableSamlAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgDisableTwoFactorRequirementAuditEntry:
+		typename = "OrgDisableTwoFactorRequirementAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgDisableTwoFactorRequirementAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgEnableOauthAppRestrictionsAuditEntry:
+		typename = "OrgEnableOauthAppRestrictionsAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgEnableOauthAppRestrictionsAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgEnableSamlAuditEntry:
+		typename = "OrgEnableSamlAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgEnableSamlAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgEnableTwoFactorRequirementAuditEntry:
+		typename = "OrgEnableTwoFactorRequirementAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgEnableTwoFactorRequirementAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgInviteMemberAuditEntry:
+		typename = "OrgInviteMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgInviteMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgInviteToBusinessAuditEntry:
+		typename = "OrgInviteToBusinessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgInviteToBusinessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgOauthAppAccessApprovedAuditEntry:
+		typename = "OrgOauthAppAccessApprovedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgOauthAppAccessApprovedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgOauthAppAccessDeniedAuditEntry:
+		typename = "OrgOauthAppAccessDeniedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgOauthAppAccessDeniedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgOauthAppAccessRequestedAuditEntry:
+		typename = "OrgOauthAppAccessRequestedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgOauthAppAccessRequestedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgRemoveBillingManagerAuditEntry:
+		typename = "OrgRemoveBillingManagerAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgRemoveBillingManagerAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgRemoveMemberAuditEntry:
+		typename = "OrgRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgRemoveOutsideCollaboratorAuditEntry:
+		typename = "OrgRemoveOutsideCollaboratorAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgRemoveOutsideCollaboratorAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgRestoreMemberAuditEntry:
+		typename = "OrgRestoreMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgRestoreMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgUnblockUserAuditEntry:
+		typename = "OrgUnblockUserAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgUnblockUserAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgUpdateDefaultRepositoryPermissionAuditEntry:
+		typename = "OrgUpdateDefaultRepositoryPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgUpdateDefaultRepositoryPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgUpdateMemberAuditEntry:
+		typename = "OrgUpdateMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgUpdateMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry:
+		typename = "OrgUpdateMemberRepositoryCreationPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry:
+		typename = "OrgUpdateMemberRepositoryInvitationPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrganizationIdentityProvider:
+		typename = "OrganizationIdentityProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrganizationIdentityProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrganizationInvitation:
+		typename = "OrganizationInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrganizationInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeOrganizationMigration:
+		typename = "OrganizationMigration"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeOrganizationMigration
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePackage:
+		typename = "Package"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePackage
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePackageFile:
+		typename = "PackageFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePackageFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePackageTag:
+		typename = "PackageTag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePackageTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePackageVersion:
+		typename = "PackageVersion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePackageVersion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePinnedDiscussion:
+		typename = "PinnedDiscussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePinnedDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePinnedEvent:
+		typename = "PinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePinnedIssue:
+		typename = "PinnedIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePinnedIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePrivateRepositoryForkingDisableAuditEntry:
+		typename = "PrivateRepositoryForkingDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePrivateRepositoryForkingDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePrivateRepositoryForkingEnableAuditEntry:
+		typename = "PrivateRepositoryForkingEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePrivateRepositoryForkingEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProject:
+		typename = "Project"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProject
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectCard:
+		typename = "ProjectCard"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectCard
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectColumn:
+		typename = "ProjectColumn"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectColumn
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2:
+		typename = "ProjectV2"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2Field:
+		typename = "ProjectV2Field"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2Field
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2Item:
+		typename = "ProjectV2Item"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2Item
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2ItemFieldDateValue:
+		typename = "ProjectV2ItemFieldDateValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2ItemFieldDateValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2ItemFieldIterationValue:
+		typename = "ProjectV2ItemFieldIterationValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2ItemFieldIterationValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2ItemFieldNumberValue:
+		typename = "ProjectV2ItemFieldNumberValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2ItemFieldNumberValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2ItemFieldSingleSelectValue:
+		typename = "ProjectV2ItemFieldSingleSelectValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2ItemFieldSingleSelectValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2ItemFieldTextValue:
+		typename = "ProjectV2ItemFieldTextValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2ItemFieldTextValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2IterationField:
+		typename = "ProjectV2IterationField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2IterationField
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2SingleSelectField:
+		typename = "ProjectV2SingleSelectField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2SingleSelectField
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2View:
+		typename = "ProjectV2View"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2View
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeProjectV2Workflow:
+		typename = "ProjectV2Workflow"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeProjectV2Workflow
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePublicKey:
+		typename = "PublicKey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePublicKey
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequest:
+		typename = "PullRequest"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetPullRequestDetailsNodePullRequest
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestCommit:
+		typename = "PullRequestCommit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestCommitCommentThread:
+		typename = "PullRequestCommitCommentThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestCommitCommentThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestReview:
+		typename = "PullRequestReview"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestReview
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestReviewComment:
+		typename = "PullRequestReviewComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestReviewComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestReviewThread:
+		typename = "PullRequestReviewThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestReviewThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestThread:
+		typename = "PullRequestThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePush:
+		typename = "Push"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePush
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePushAllowance:
+		typename = "PushAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePushAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReaction:
+		typename = "Reaction"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReaction
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReadyForReviewEvent:
+		typename = "ReadyForReviewEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReadyForReviewEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRef:
+		typename = "Ref"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRef
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReferencedEvent:
+		typename = "ReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRelease:
+		typename = "Release"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRelease
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReleaseAsset:
+		typename = "ReleaseAsset"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReleaseAsset
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRemovedFromProjectEvent:
+		typename = "RemovedFromProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRemovedFromProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRenamedTitleEvent:
+		typename = "RenamedTitleEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRenamedTitleEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReopenedEvent:
+		typename = "ReopenedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReopenedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoAccessAuditEntry:
+		typename = "RepoAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoAddMemberAuditEntry:
+		typename = "RepoAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoAddTopicAuditEntry:
+		typename = "RepoAddTopicAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoAddTopicAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoArchivedAuditEntry:
+		typename = "RepoArchivedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoArchivedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoChangeMergeSettingAuditEntry:
+		typename = "RepoChangeMergeSettingAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoChangeMergeSettingAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigDisableAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigDisableAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigDisableAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigDisableCollaboratorsOnlyAuditEntry:
+		typename = "RepoConfigDisableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigDisableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigDisableContributorsOnlyAuditEntry:
+		typename = "RepoConfigDisableContributorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigDisableContributorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigDisableSockpuppetDisallowedAuditEntry:
+		typename = "RepoConfigDisableSockpuppetDisallowedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigDisableSockpuppetDisallowedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigEnableAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigEnableAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigEnableAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigEnableCollaboratorsOnlyAuditEntry:
+		typename = "RepoConfigEnableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigEnableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigEnableContributorsOnlyAuditEntry:
+		typename = "RepoConfigEnableContributorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigEnableContributorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigEnableSockpuppetDisallowedAuditEntry:
+		typename = "RepoConfigEnableSockpuppetDisallowedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigEnableSockpuppetDisallowedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigLockAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigLockAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigLockAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoConfigUnlockAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigUnlockAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoConfigUnlockAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoCreateAuditEntry:
+		typename = "RepoCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoDestroyAuditEntry:
+		typename = "RepoDestroyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoDestroyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoRemoveMemberAuditEntry:
+		typename = "RepoRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepoRemoveTopicAuditEntry:
+		typename = "RepoRemoveTopicAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepoRemoveTopicAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepository:
+		typename = "Repository"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepository
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepositoryInvitation:
+		typename = "RepositoryInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepositoryInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepositoryMigration:
+		typename = "RepositoryMigration"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepositoryMigration
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepositoryTopic:
+		typename = "RepositoryTopic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepositoryTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepositoryVisibilityChangeDisableAuditEntry:
+		typename = "RepositoryVisibilityChangeDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepositoryVisibilityChangeDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepositoryVisibilityChangeEnableAuditEntry:
+		typename = "RepositoryVisibilityChangeEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepositoryVisibilityChangeEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeRepositoryVulnerabilityAlert:
+		typename = "RepositoryVulnerabilityAlert"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeRepositoryVulnerabilityAlert
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReviewDismissalAllowance:
+		typename = "ReviewDismissalAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReviewDismissalAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReviewDismissedEvent:
+		typename = "ReviewDismissedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReviewDismissedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReviewRequest:
+		typename = "ReviewRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReviewRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReviewRequestRemovedEvent:
+		typename = "ReviewRequestRemovedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReviewRequestRemovedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeReviewRequestedEvent:
+		typename = "ReviewRequestedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeReviewRequestedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeSavedReply:
+		typename = "SavedReply"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeSavedReply
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeSecurityAdvisory:
+		typename = "SecurityAdvisory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeSecurityAdvisory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeSponsorsActivity:
+		typename = "SponsorsActivity"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeSponsorsActivity
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeSponsorsListing:
+		typename = "SponsorsListing"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeSponsorsListing
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeSponsorsListingFeaturedItem:
+		typename = "SponsorsListingFeaturedItem"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeSponsorsListingFeaturedItem
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeSponsorsTier:
+		typename = "SponsorsTier"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeSponsorsTier
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeSponsorship:
+		typename = "Sponsorship"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeSponsorship
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeSponsorshipNewsletter:
+		typename = "SponsorshipNewsletter"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeSponsorshipNewsletter
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeStatus:
+		typename = "Status"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeStatusCheckRollup:
+		typename = "StatusCheckRollup"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeStatusCheckRollup
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeStatusContext:
+		typename = "StatusContext"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeStatusContext
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeSubscribedEvent:
+		typename = "SubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeSubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTeam:
+		typename = "Team"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTeam
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTeamAddMemberAuditEntry:
+		typename = "TeamAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTeamAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTeamAddRepositoryAuditEntry:
+		typename = "TeamAddRepositoryAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTeamAddRepositoryAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTeamChangeParentTeamAuditEntry:
+		typename = "TeamChangeParentTeamAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTeamChangeParentTeamAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTeamDiscussion:
+		typename = "TeamDiscussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTeamDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTeamDiscussionComment:
+		typename = "TeamDiscussionComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTeamDiscussionComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTeamRemoveMemberAuditEntry:
+		typename = "TeamRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTeamRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTeamRemoveRepositoryAuditEntry:
+		typename = "TeamRemoveRepositoryAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTeamRemoveRepositoryAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTransferredEvent:
+		typename = "TransferredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTransferredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeTree
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUnassignedEvent:
+		typename = "UnassignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUnassignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUnlabeledEvent:
+		typename = "UnlabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUnlabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUnlockedEvent:
+		typename = "UnlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUnlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUnmarkedAsDuplicateEvent:
+		typename = "UnmarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUnmarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUnpinnedEvent:
+		typename = "UnpinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUnpinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUnsubscribedEvent:
+		typename = "UnsubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUnsubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUserBlockedEvent:
+		typename = "UserBlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUserBlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUserContentEdit:
+		typename = "UserContentEdit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUserContentEdit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeUserStatus:
+		typename = "UserStatus"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeUserStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeVerifiableDomain:
+		typename = "VerifiableDomain"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeVerifiableDomain
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeWorkflow:
+		typename = "Workflow"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeWorkflow
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodeWorkflowRun:
+		typename = "WorkflowRun"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodeWorkflowRun
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getPullRequestDetailsNode: "%T"`, v)
+	}
+}
+
+// getPullRequestDetailsNodeAddedToProjectEvent includes the requested fields of the GraphQL type AddedToProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'added_to_project' event on a given issue or pull request.
+type getPullRequestDetailsNodeAddedToProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeAddedToProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeAddedToProjectEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeApp includes the requested fields of the GraphQL type App.
+// The GraphQL type's documentation follows.
+//
+// A GitHub App.
+type getPullRequestDetailsNodeApp struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeApp.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeApp) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeAssignedEvent includes the requested fields of the GraphQL type AssignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'assigned' event on any assignable object.
+type getPullRequestDetailsNodeAssignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeAssignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeAssignedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeAutoMergeDisabledEvent includes the requested fields of the GraphQL type AutoMergeDisabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_disabled' event on a given pull request.
+type getPullRequestDetailsNodeAutoMergeDisabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeAutoMergeDisabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeAutoMergeDisabledEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeAutoMergeEnabledEvent includes the requested fields of the GraphQL type AutoMergeEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_enabled' event on a given pull request.
+type getPullRequestDetailsNodeAutoMergeEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeAutoMergeEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeAutoMergeEnabledEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeAutoRebaseEnabledEvent includes the requested fields of the GraphQL type AutoRebaseEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_rebase_enabled' event on a given pull request.
+type getPullRequestDetailsNodeAutoRebaseEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeAutoRebaseEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeAutoRebaseEnabledEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeAutoSquashEnabledEvent includes the requested fields of the GraphQL type AutoSquashEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_squash_enabled' event on a given pull request.
+type getPullRequestDetailsNodeAutoSquashEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeAutoSquashEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeAutoSquashEnabledEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeAutomaticBaseChangeFailedEvent includes the requested fields of the GraphQL type AutomaticBaseChangeFailedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_failed' event on a given pull request.
+type getPullRequestDetailsNodeAutomaticBaseChangeFailedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeAutomaticBaseChangeFailedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeAutomaticBaseChangeFailedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeAutomaticBaseChangeSucceededEvent includes the requested fields of the GraphQL type AutomaticBaseChangeSucceededEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_succeeded' event on a given pull request.
+type getPullRequestDetailsNodeAutomaticBaseChangeSucceededEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeAutomaticBaseChangeSucceededEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeAutomaticBaseChangeSucceededEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeBaseRefChangedEvent includes the requested fields of the GraphQL type BaseRefChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_changed' event on a given issue or pull request.
+type getPullRequestDetailsNodeBaseRefChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeBaseRefChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeBaseRefChangedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeBaseRefDeletedEvent includes the requested fields of the GraphQL type BaseRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_deleted' event on a given pull request.
+type getPullRequestDetailsNodeBaseRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeBaseRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeBaseRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeBaseRefForcePushedEvent includes the requested fields of the GraphQL type BaseRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_force_pushed' event on a given pull request.
+type getPullRequestDetailsNodeBaseRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeBaseRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeBaseRefForcePushedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type getPullRequestDetailsNodeBlob struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeBlob) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeBot includes the requested fields of the GraphQL type Bot.
+// The GraphQL type's documentation follows.
+//
+// A special type of user which takes actions on behalf of GitHub Apps.
+type getPullRequestDetailsNodeBot struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeBot.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeBot) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeBranchProtectionRule includes the requested fields of the GraphQL type BranchProtectionRule.
+// The GraphQL type's documentation follows.
+//
+// A branch protection rule.
+type getPullRequestDetailsNodeBranchProtectionRule struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeBranchProtectionRule.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeBranchProtectionRule) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeBypassForcePushAllowance includes the requested fields of the GraphQL type BypassForcePushAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to bypass a force push requirement on a protected branch.
+type getPullRequestDetailsNodeBypassForcePushAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeBypassForcePushAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeBypassForcePushAllowance) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeBypassPullRequestAllowance includes the requested fields of the GraphQL type BypassPullRequestAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to bypass a pull request requirement on a protected branch.
+type getPullRequestDetailsNodeBypassPullRequestAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeBypassPullRequestAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeBypassPullRequestAllowance) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeCWE includes the requested fields of the GraphQL type CWE.
+// The GraphQL type's documentation follows.
+//
+// A common weakness enumeration
+type getPullRequestDetailsNodeCWE struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeCWE.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeCWE) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeCheckRun includes the requested fields of the GraphQL type CheckRun.
+// The GraphQL type's documentation follows.
+//
+// A check run.
+type getPullRequestDetailsNodeCheckRun struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeCheckRun.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeCheckRun) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeCheckSuite includes the requested fields of the GraphQL type CheckSuite.
+// The GraphQL type's documentation follows.
+//
+// A check suite.
+type getPullRequestDetailsNodeCheckSuite struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeCheckSuite.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeCheckSuite) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeClosedEvent includes the requested fields of the GraphQL type ClosedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'closed' event on any `Closable`.
+type getPullRequestDetailsNodeClosedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeClosedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeClosedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeCodeOfConduct includes the requested fields of the GraphQL type CodeOfConduct.
+// The GraphQL type's documentation follows.
+//
+// The Code of Conduct for a repository
+type getPullRequestDetailsNodeCodeOfConduct struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeCodeOfConduct.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeCodeOfConduct) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeCommentDeletedEvent includes the requested fields of the GraphQL type CommentDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'comment_deleted' event on a given issue or pull request.
+type getPullRequestDetailsNodeCommentDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeCommentDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeCommentDeletedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getPullRequestDetailsNodeCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeCommit) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeCommitComment includes the requested fields of the GraphQL type CommitComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on a given Commit.
+type getPullRequestDetailsNodeCommitComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeCommitComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeCommitComment) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeCommitCommentThread includes the requested fields of the GraphQL type CommitCommentThread.
+// The GraphQL type's documentation follows.
+//
+// A thread of comments on a commit.
+type getPullRequestDetailsNodeCommitCommentThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeCommitCommentThread.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeCommitCommentThread) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeComparison includes the requested fields of the GraphQL type Comparison.
+// The GraphQL type's documentation follows.
+//
+// Represents a comparison between two commit revisions.
+type getPullRequestDetailsNodeComparison struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeComparison.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeComparison) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeConnectedEvent includes the requested fields of the GraphQL type ConnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'connected' event on a given issue or pull request.
+type getPullRequestDetailsNodeConnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeConnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeConnectedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeConvertToDraftEvent includes the requested fields of the GraphQL type ConvertToDraftEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'convert_to_draft' event on a given pull request.
+type getPullRequestDetailsNodeConvertToDraftEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeConvertToDraftEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeConvertToDraftEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeConvertedNoteToIssueEvent includes the requested fields of the GraphQL type ConvertedNoteToIssueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_note_to_issue' event on a given issue or pull request.
+type getPullRequestDetailsNodeConvertedNoteToIssueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeConvertedNoteToIssueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeConvertedNoteToIssueEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeConvertedToDiscussionEvent includes the requested fields of the GraphQL type ConvertedToDiscussionEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_to_discussion' event on a given issue.
+type getPullRequestDetailsNodeConvertedToDiscussionEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeConvertedToDiscussionEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeConvertedToDiscussionEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeCrossReferencedEvent includes the requested fields of the GraphQL type CrossReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a mention made by one issue or pull request to another.
+type getPullRequestDetailsNodeCrossReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeCrossReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeCrossReferencedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDemilestonedEvent includes the requested fields of the GraphQL type DemilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'demilestoned' event on a given issue or pull request.
+type getPullRequestDetailsNodeDemilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDemilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDemilestonedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDependencyGraphManifest includes the requested fields of the GraphQL type DependencyGraphManifest.
+// The GraphQL type's documentation follows.
+//
+// Dependency manifest for a repository
+type getPullRequestDetailsNodeDependencyGraphManifest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDependencyGraphManifest.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDependencyGraphManifest) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDeployKey includes the requested fields of the GraphQL type DeployKey.
+// The GraphQL type's documentation follows.
+//
+// A repository deploy key.
+type getPullRequestDetailsNodeDeployKey struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDeployKey.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDeployKey) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDeployedEvent includes the requested fields of the GraphQL type DeployedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployed' event on a given pull request.
+type getPullRequestDetailsNodeDeployedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDeployedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDeployedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDeployment includes the requested fields of the GraphQL type Deployment.
+// The GraphQL type's documentation follows.
+//
+// Represents triggered deployment instance.
+type getPullRequestDetailsNodeDeployment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDeployment) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDeploymentEnvironmentChangedEvent includes the requested fields of the GraphQL type DeploymentEnvironmentChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployment_environment_changed' event on a given pull request.
+type getPullRequestDetailsNodeDeploymentEnvironmentChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDeploymentEnvironmentChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDeploymentEnvironmentChangedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeDeploymentReview includes the requested fields of the GraphQL type DeploymentReview.
+// The GraphQL type's documentation follows.
+//
+// A deployment review.
+type getPullRequestDetailsNodeDeploymentReview struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDeploymentReview.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDeploymentReview) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDeploymentStatus includes the requested fields of the GraphQL type DeploymentStatus.
+// The GraphQL type's documentation follows.
+//
+// Describes the status of a given deployment attempt.
+type getPullRequestDetailsNodeDeploymentStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDeploymentStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDeploymentStatus) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDisconnectedEvent includes the requested fields of the GraphQL type DisconnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'disconnected' event on a given issue or pull request.
+type getPullRequestDetailsNodeDisconnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDisconnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDisconnectedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDiscussion includes the requested fields of the GraphQL type Discussion.
+// The GraphQL type's documentation follows.
+//
+// A discussion in a repository.
+type getPullRequestDetailsNodeDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDiscussion) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDiscussionCategory includes the requested fields of the GraphQL type DiscussionCategory.
+// The GraphQL type's documentation follows.
+//
+// A category for discussions in a repository.
+type getPullRequestDetailsNodeDiscussionCategory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDiscussionCategory.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDiscussionCategory) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDiscussionComment includes the requested fields of the GraphQL type DiscussionComment.
+// The GraphQL type's documentation follows.
+//
+// A comment on a discussion.
+type getPullRequestDetailsNodeDiscussionComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDiscussionComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDiscussionComment) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDiscussionPoll includes the requested fields of the GraphQL type DiscussionPoll.
+// The GraphQL type's documentation follows.
+//
+// A poll for a discussion.
+type getPullRequestDetailsNodeDiscussionPoll struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDiscussionPoll.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDiscussionPoll) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDiscussionPollOption includes the requested fields of the GraphQL type DiscussionPollOption.
+// The GraphQL type's documentation follows.
+//
+// An option for a discussion poll.
+type getPullRequestDetailsNodeDiscussionPollOption struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDiscussionPollOption.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDiscussionPollOption) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeDraftIssue includes the requested fields of the GraphQL type DraftIssue.
+// The GraphQL type's documentation follows.
+//
+// A draft issue within a project.
+type getPullRequestDetailsNodeDraftIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeDraftIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeDraftIssue) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeEnterprise includes the requested fields of the GraphQL type Enterprise.
+// The GraphQL type's documentation follows.
+//
+// An account to manage multiple organizations with consolidated policy and billing.
+type getPullRequestDetailsNodeEnterprise struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnterprise.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnterprise) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeEnterpriseAdministratorInvitation includes the requested fields of the GraphQL type EnterpriseAdministratorInvitation.
+// The GraphQL type's documentation follows.
+//
+// An invitation for a user to become an owner or billing manager of an enterprise.
+type getPullRequestDetailsNodeEnterpriseAdministratorInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnterpriseAdministratorInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnterpriseAdministratorInvitation) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeEnterpriseIdentityProvider includes the requested fields of the GraphQL type EnterpriseIdentityProvider.
+// The GraphQL type's documentation follows.
+//
+// An identity provider configured to provision identities for an enterprise.
+type getPullRequestDetailsNodeEnterpriseIdentityProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnterpriseIdentityProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnterpriseIdentityProvider) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeEnterpriseRepositoryInfo includes the requested fields of the GraphQL type EnterpriseRepositoryInfo.
+// The GraphQL type's documentation follows.
+//
+// A subset of repository information queryable from an enterprise.
+type getPullRequestDetailsNodeEnterpriseRepositoryInfo struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnterpriseRepositoryInfo.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnterpriseRepositoryInfo) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeEnterpriseServerInstallation includes the requested fields of the GraphQL type EnterpriseServerInstallation.
+// The GraphQL type's documentation follows.
+//
+// An Enterprise Server installation.
+type getPullRequestDetailsNodeEnterpriseServerInstallation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnterpriseServerInstallation.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnterpriseServerInstallation) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeEnterpriseServerUserAccount includes the requested fields of the GraphQL type EnterpriseServerUserAccount.
+// The GraphQL type's documentation follows.
+//
+// A user account on an Enterprise Server installation.
+type getPullRequestDetailsNodeEnterpriseServerUserAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnterpriseServerUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnterpriseServerUserAccount) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeEnterpriseServerUserAccountEmail includes the requested fields of the GraphQL type EnterpriseServerUserAccountEmail.
+// The GraphQL type's documentation follows.
+//
+// An email belonging to a user account on an Enterprise Server installation.
+type getPullRequestDetailsNodeEnterpriseServerUserAccountEmail struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnterpriseServerUserAccountEmail.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnterpriseServerUserAccountEmail) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeEnterpriseServerUserAccountsUpload includes the requested fields of the GraphQL type EnterpriseServerUserAccountsUpload.
+// The GraphQL type's documentation follows.
+//
+// A user accounts upload from an Enterprise Server installation.
+type getPullRequestDetailsNodeEnterpriseServerUserAccountsUpload struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnterpriseServerUserAccountsUpload.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnterpriseServerUserAccountsUpload) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// The GraphQL type's documentation follows.
+//
+// An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
+type getPullRequestDetailsNodeEnterpriseUserAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnterpriseUserAccount) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// An environment.
+type getPullRequestDetailsNodeEnvironment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeEnvironment.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeEnvironment) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeExternalIdentity includes the requested fields of the GraphQL type ExternalIdentity.
+// The GraphQL type's documentation follows.
+//
+// An external identity provisioned by SAML SSO or SCIM.
+type getPullRequestDetailsNodeExternalIdentity struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeExternalIdentity.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeExternalIdentity) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeGist includes the requested fields of the GraphQL type Gist.
+// The GraphQL type's documentation follows.
+//
+// A Gist.
+type getPullRequestDetailsNodeGist struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeGist.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeGist) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeGistComment includes the requested fields of the GraphQL type GistComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on an Gist.
+type getPullRequestDetailsNodeGistComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeGistComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeGistComment) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeHeadRefDeletedEvent includes the requested fields of the GraphQL type HeadRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_deleted' event on a given pull request.
+type getPullRequestDetailsNodeHeadRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeHeadRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeHeadRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeHeadRefForcePushedEvent includes the requested fields of the GraphQL type HeadRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_force_pushed' event on a given pull request.
+type getPullRequestDetailsNodeHeadRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeHeadRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeHeadRefForcePushedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeHeadRefRestoredEvent includes the requested fields of the GraphQL type HeadRefRestoredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_restored' event on a given pull request.
+type getPullRequestDetailsNodeHeadRefRestoredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeHeadRefRestoredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeHeadRefRestoredEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeIpAllowListEntry includes the requested fields of the GraphQL type IpAllowListEntry.
+// The GraphQL type's documentation follows.
+//
+// An IP address or range of addresses that is allowed to access an owner's resources.
+type getPullRequestDetailsNodeIpAllowListEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeIpAllowListEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeIpAllowListEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type getPullRequestDetailsNodeIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeIssue) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeIssueComment includes the requested fields of the GraphQL type IssueComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on an Issue.
+type getPullRequestDetailsNodeIssueComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeIssueComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeIssueComment) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type getPullRequestDetailsNodeLabel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeLabel.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeLabel) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeLabeledEvent includes the requested fields of the GraphQL type LabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'labeled' event on a given issue or pull request.
+type getPullRequestDetailsNodeLabeledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeLabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeLabeledEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeLanguage includes the requested fields of the GraphQL type Language.
+// The GraphQL type's documentation follows.
+//
+// Represents a given language found in repositories.
+type getPullRequestDetailsNodeLanguage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeLanguage.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeLanguage) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeLicense includes the requested fields of the GraphQL type License.
+// The GraphQL type's documentation follows.
+//
+// A repository's open source license
+type getPullRequestDetailsNodeLicense struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeLicense.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeLicense) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeLinkedBranch includes the requested fields of the GraphQL type LinkedBranch.
+// The GraphQL type's documentation follows.
+//
+// A branch linked to an issue.
+type getPullRequestDetailsNodeLinkedBranch struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeLinkedBranch.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeLinkedBranch) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeLockedEvent includes the requested fields of the GraphQL type LockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'locked' event on a given issue or pull request.
+type getPullRequestDetailsNodeLockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeLockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeLockedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMannequin includes the requested fields of the GraphQL type Mannequin.
+// The GraphQL type's documentation follows.
+//
+// A placeholder user for attribution of imported data on GitHub.
+type getPullRequestDetailsNodeMannequin struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMannequin) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMarkedAsDuplicateEvent includes the requested fields of the GraphQL type MarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'marked_as_duplicate' event on a given issue or pull request.
+type getPullRequestDetailsNodeMarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMarketplaceCategory includes the requested fields of the GraphQL type MarketplaceCategory.
+// The GraphQL type's documentation follows.
+//
+// A public description of a Marketplace category.
+type getPullRequestDetailsNodeMarketplaceCategory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMarketplaceCategory.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMarketplaceCategory) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMarketplaceListing includes the requested fields of the GraphQL type MarketplaceListing.
+// The GraphQL type's documentation follows.
+//
+// A listing in the GitHub integration marketplace.
+type getPullRequestDetailsNodeMarketplaceListing struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMarketplaceListing.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMarketplaceListing) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMembersCanDeleteReposClearAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposClearAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.clear event.
+type getPullRequestDetailsNodeMembersCanDeleteReposClearAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMembersCanDeleteReposClearAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMembersCanDeleteReposClearAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeMembersCanDeleteReposDisableAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.disable event.
+type getPullRequestDetailsNodeMembersCanDeleteReposDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMembersCanDeleteReposDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMembersCanDeleteReposDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeMembersCanDeleteReposEnableAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.enable event.
+type getPullRequestDetailsNodeMembersCanDeleteReposEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMembersCanDeleteReposEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMembersCanDeleteReposEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeMentionedEvent includes the requested fields of the GraphQL type MentionedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'mentioned' event on a given issue or pull request.
+type getPullRequestDetailsNodeMentionedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMentionedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMentionedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMergedEvent includes the requested fields of the GraphQL type MergedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'merged' event on a given pull request.
+type getPullRequestDetailsNodeMergedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMergedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMergedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMigrationSource includes the requested fields of the GraphQL type MigrationSource.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) migration source.
+type getPullRequestDetailsNodeMigrationSource struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMigrationSource.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMigrationSource) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMilestone includes the requested fields of the GraphQL type Milestone.
+// The GraphQL type's documentation follows.
+//
+// Represents a Milestone object on a given repository.
+type getPullRequestDetailsNodeMilestone struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMilestone.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMilestone) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMilestonedEvent includes the requested fields of the GraphQL type MilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'milestoned' event on a given issue or pull request.
+type getPullRequestDetailsNodeMilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMilestonedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeMovedColumnsInProjectEvent includes the requested fields of the GraphQL type MovedColumnsInProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'moved_columns_in_project' event on a given issue or pull request.
+type getPullRequestDetailsNodeMovedColumnsInProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeMovedColumnsInProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeMovedColumnsInProjectEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOIDCProvider includes the requested fields of the GraphQL type OIDCProvider.
+// The GraphQL type's documentation follows.
+//
+// An OIDC identity provider configured to provision identities for an enterprise.
+type getPullRequestDetailsNodeOIDCProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOIDCProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOIDCProvider) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOauthApplicationCreateAuditEntry includes the requested fields of the GraphQL type OauthApplicationCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a oauth_application.create event.
+type getPullRequestDetailsNodeOauthApplicationCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOauthApplicationCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOauthApplicationCreateAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgAddBillingManagerAuditEntry includes the requested fields of the GraphQL type OrgAddBillingManagerAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.add_billing_manager
+type getPullRequestDetailsNodeOrgAddBillingManagerAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgAddBillingManagerAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgAddBillingManagerAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgAddMemberAuditEntry includes the requested fields of the GraphQL type OrgAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.add_member
+type getPullRequestDetailsNodeOrgAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgAddMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgBlockUserAuditEntry includes the requested fields of the GraphQL type OrgBlockUserAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.block_user
+type getPullRequestDetailsNodeOrgBlockUserAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgBlockUserAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgBlockUserAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgConfigDisableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type OrgConfigDisableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.config.disable_collaborators_only event.
+type getPullRequestDetailsNodeOrgConfigDisableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgConfigDisableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgConfigDisableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgConfigEnableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type OrgConfigEnableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.config.enable_collaborators_only event.
+type getPullRequestDetailsNodeOrgConfigEnableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgConfigEnableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgConfigEnableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgCreateAuditEntry includes the requested fields of the GraphQL type OrgCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.create event.
+type getPullRequestDetailsNodeOrgCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgCreateAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgDisableOauthAppRestrictionsAuditEntry includes the requested fields of the GraphQL type OrgDisableOauthAppRestrictionsAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_oauth_app_restrictions event.
+type getPullRequestDetailsNodeOrgDisableOauthAppRestrictionsAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgDisableOauthAppRestrictionsAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgDisableOauthAppRestrictionsAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgDisableSamlAuditEntry includes the requested fields of the GraphQL type OrgDisableSamlAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_saml event.
+type getPullRequestDetailsNodeOrgDisableSamlAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgDisableSamlAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgDisableSamlAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgDisableTwoFactorRequirementAuditEntry includes the requested fields of the GraphQL type OrgDisableTwoFactorRequirementAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_two_factor_requirement event.
+type getPullRequestDetailsNodeOrgDisableTwoFactorRequirementAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgDisableTwoFactorRequirementAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgDisableTwoFactorRequirementAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgEnableOauthAppRestrictionsAuditEntry includes the requested fields of the GraphQL type OrgEnableOauthAppRestrictionsAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_oauth_app_restrictions event.
+type getPullRequestDetailsNodeOrgEnableOauthAppRestrictionsAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgEnableOauthAppRestrictionsAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgEnableOauthAppRestrictionsAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgEnableSamlAuditEntry includes the requested fields of the GraphQL type OrgEnableSamlAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_saml event.
+type getPullRequestDetailsNodeOrgEnableSamlAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgEnableSamlAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgEnableSamlAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgEnableTwoFactorRequirementAuditEntry includes the requested fields of the GraphQL type OrgEnableTwoFactorRequirementAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_two_factor_requirement event.
+type getPullRequestDetailsNodeOrgEnableTwoFactorRequirementAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgEnableTwoFactorRequirementAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgEnableTwoFactorRequirementAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgInviteMemberAuditEntry includes the requested fields of the GraphQL type OrgInviteMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.invite_member event.
+type getPullRequestDetailsNodeOrgInviteMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgInviteMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgInviteMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgInviteToBusinessAuditEntry includes the requested fields of the GraphQL type OrgInviteToBusinessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.invite_to_business event.
+type getPullRequestDetailsNodeOrgInviteToBusinessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgInviteToBusinessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgInviteToBusinessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgOauthAppAccessApprovedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessApprovedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_approved event.
+type getPullRequestDetailsNodeOrgOauthAppAccessApprovedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgOauthAppAccessApprovedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgOauthAppAccessApprovedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgOauthAppAccessDeniedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessDeniedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_denied event.
+type getPullRequestDetailsNodeOrgOauthAppAccessDeniedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgOauthAppAccessDeniedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgOauthAppAccessDeniedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgOauthAppAccessRequestedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessRequestedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_requested event.
+type getPullRequestDetailsNodeOrgOauthAppAccessRequestedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgOauthAppAccessRequestedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgOauthAppAccessRequestedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgRemoveBillingManagerAuditEntry includes the requested fields of the GraphQL type OrgRemoveBillingManagerAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_billing_manager event.
+type getPullRequestDetailsNodeOrgRemoveBillingManagerAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgRemoveBillingManagerAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgRemoveBillingManagerAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgRemoveMemberAuditEntry includes the requested fields of the GraphQL type OrgRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_member event.
+type getPullRequestDetailsNodeOrgRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgRemoveMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgRemoveOutsideCollaboratorAuditEntry includes the requested fields of the GraphQL type OrgRemoveOutsideCollaboratorAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_outside_collaborator event.
+type getPullRequestDetailsNodeOrgRemoveOutsideCollaboratorAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgRemoveOutsideCollaboratorAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgRemoveOutsideCollaboratorAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgRestoreMemberAuditEntry includes the requested fields of the GraphQL type OrgRestoreMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.restore_member event.
+type getPullRequestDetailsNodeOrgRestoreMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgRestoreMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgRestoreMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgUnblockUserAuditEntry includes the requested fields of the GraphQL type OrgUnblockUserAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.unblock_user
+type getPullRequestDetailsNodeOrgUnblockUserAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgUnblockUserAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgUnblockUserAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgUpdateDefaultRepositoryPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateDefaultRepositoryPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_default_repository_permission
+type getPullRequestDetailsNodeOrgUpdateDefaultRepositoryPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgUpdateDefaultRepositoryPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgUpdateDefaultRepositoryPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgUpdateMemberAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member event.
+type getPullRequestDetailsNodeOrgUpdateMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgUpdateMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgUpdateMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberRepositoryCreationPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member_repository_creation_permission event.
+type getPullRequestDetailsNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberRepositoryInvitationPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member_repository_invitation_permission event.
+type getPullRequestDetailsNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getPullRequestDetailsNodeOrganization struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrganization) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrganizationIdentityProvider includes the requested fields of the GraphQL type OrganizationIdentityProvider.
+// The GraphQL type's documentation follows.
+//
+// An Identity Provider configured to provision SAML and SCIM identities for Organizations
+type getPullRequestDetailsNodeOrganizationIdentityProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrganizationIdentityProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrganizationIdentityProvider) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeOrganizationInvitation includes the requested fields of the GraphQL type OrganizationInvitation.
+// The GraphQL type's documentation follows.
+//
+// An Invitation for a user to an organization.
+type getPullRequestDetailsNodeOrganizationInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrganizationInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrganizationInvitation) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeOrganizationMigration includes the requested fields of the GraphQL type OrganizationMigration.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) organization migration.
+type getPullRequestDetailsNodeOrganizationMigration struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeOrganizationMigration.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeOrganizationMigration) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePackage includes the requested fields of the GraphQL type Package.
+// The GraphQL type's documentation follows.
+//
+// Information for an uploaded package.
+type getPullRequestDetailsNodePackage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePackage.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePackage) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePackageFile includes the requested fields of the GraphQL type PackageFile.
+// The GraphQL type's documentation follows.
+//
+// A file in a package version.
+type getPullRequestDetailsNodePackageFile struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePackageFile.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePackageFile) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePackageTag includes the requested fields of the GraphQL type PackageTag.
+// The GraphQL type's documentation follows.
+//
+// A version tag contains the mapping between a tag name and a version.
+type getPullRequestDetailsNodePackageTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePackageTag.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePackageTag) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePackageVersion includes the requested fields of the GraphQL type PackageVersion.
+// The GraphQL type's documentation follows.
+//
+// Information about a specific package version.
+type getPullRequestDetailsNodePackageVersion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePackageVersion.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePackageVersion) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePinnedDiscussion includes the requested fields of the GraphQL type PinnedDiscussion.
+// The GraphQL type's documentation follows.
+//
+// A Pinned Discussion is a discussion pinned to a repository's index page.
+type getPullRequestDetailsNodePinnedDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePinnedDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePinnedDiscussion) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePinnedEvent includes the requested fields of the GraphQL type PinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'pinned' event on a given issue or pull request.
+type getPullRequestDetailsNodePinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePinnedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePinnedIssue includes the requested fields of the GraphQL type PinnedIssue.
+// The GraphQL type's documentation follows.
+//
+// A Pinned Issue is a issue pinned to a repository's index page.
+type getPullRequestDetailsNodePinnedIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePinnedIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePinnedIssue) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePrivateRepositoryForkingDisableAuditEntry includes the requested fields of the GraphQL type PrivateRepositoryForkingDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a private_repository_forking.disable event.
+type getPullRequestDetailsNodePrivateRepositoryForkingDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePrivateRepositoryForkingDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePrivateRepositoryForkingDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodePrivateRepositoryForkingEnableAuditEntry includes the requested fields of the GraphQL type PrivateRepositoryForkingEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a private_repository_forking.enable event.
+type getPullRequestDetailsNodePrivateRepositoryForkingEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePrivateRepositoryForkingEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePrivateRepositoryForkingEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// Projects manage issues, pull requests and notes within a project owner.
+type getPullRequestDetailsNodeProject struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProject.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProject) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeProjectCard includes the requested fields of the GraphQL type ProjectCard.
+// The GraphQL type's documentation follows.
+//
+// A card in a project.
+type getPullRequestDetailsNodeProjectCard struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectCard.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectCard) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeProjectColumn includes the requested fields of the GraphQL type ProjectColumn.
+// The GraphQL type's documentation follows.
+//
+// A column inside a project.
+type getPullRequestDetailsNodeProjectColumn struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectColumn.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectColumn) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getPullRequestDetailsNodeProjectV2 struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeProjectV2Field includes the requested fields of the GraphQL type ProjectV2Field.
+// The GraphQL type's documentation follows.
+//
+// A field inside a project.
+type getPullRequestDetailsNodeProjectV2Field struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2Field.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2Field) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeProjectV2Item includes the requested fields of the GraphQL type ProjectV2Item.
+// The GraphQL type's documentation follows.
+//
+// An item within a Project.
+type getPullRequestDetailsNodeProjectV2Item struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2Item.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2Item) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeProjectV2ItemFieldDateValue includes the requested fields of the GraphQL type ProjectV2ItemFieldDateValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a date field in a Project item.
+type getPullRequestDetailsNodeProjectV2ItemFieldDateValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2ItemFieldDateValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldDateValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeProjectV2ItemFieldIterationValue includes the requested fields of the GraphQL type ProjectV2ItemFieldIterationValue.
+// The GraphQL type's documentation follows.
+//
+// The value of an iteration field in a Project item.
+type getPullRequestDetailsNodeProjectV2ItemFieldIterationValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2ItemFieldIterationValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldIterationValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeProjectV2ItemFieldNumberValue includes the requested fields of the GraphQL type ProjectV2ItemFieldNumberValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a number field in a Project item.
+type getPullRequestDetailsNodeProjectV2ItemFieldNumberValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2ItemFieldNumberValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldNumberValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeProjectV2ItemFieldSingleSelectValue includes the requested fields of the GraphQL type ProjectV2ItemFieldSingleSelectValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a single select field in a Project item.
+type getPullRequestDetailsNodeProjectV2ItemFieldSingleSelectValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2ItemFieldSingleSelectValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldSingleSelectValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeProjectV2ItemFieldTextValue includes the requested fields of the GraphQL type ProjectV2ItemFieldTextValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a text field in a Project item.
+type getPullRequestDetailsNodeProjectV2ItemFieldTextValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2ItemFieldTextValue.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2ItemFieldTextValue) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeProjectV2IterationField includes the requested fields of the GraphQL type ProjectV2IterationField.
+// The GraphQL type's documentation follows.
+//
+// An iteration field inside a project.
+type getPullRequestDetailsNodeProjectV2IterationField struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2IterationField.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2IterationField) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeProjectV2SingleSelectField includes the requested fields of the GraphQL type ProjectV2SingleSelectField.
+// The GraphQL type's documentation follows.
+//
+// A single select field inside a project.
+type getPullRequestDetailsNodeProjectV2SingleSelectField struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2SingleSelectField.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2SingleSelectField) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeProjectV2View includes the requested fields of the GraphQL type ProjectV2View.
+// The GraphQL type's documentation follows.
+//
+// A view within a ProjectV2.
+type getPullRequestDetailsNodeProjectV2View struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2View.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2View) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeProjectV2Workflow includes the requested fields of the GraphQL type ProjectV2Workflow.
+// The GraphQL type's documentation follows.
+//
+// A workflow inside a project.
+type getPullRequestDetailsNodeProjectV2Workflow struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeProjectV2Workflow.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeProjectV2Workflow) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePublicKey includes the requested fields of the GraphQL type PublicKey.
+// The GraphQL type's documentation follows.
+//
+// A user's public key.
+type getPullRequestDetailsNodePublicKey struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePublicKey.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePublicKey) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPullRequestDetailsNodePullRequest struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	// Identifies the pull request number.
+	Number int `json:"number"`
+	// Identifies the pull request title.
+	Title string `json:"title"`
+	// The body as Markdown.
+	Body string `json:"body"`
+	// The HTTP URL for this pull request.
+	Url string `json:"url"`
+	// Identifies if the pull request is a draft.
+	IsDraft bool `json:"isDraft"`
+	// The actor who authored the comment.
+	Author getPullRequestDetailsNodePullRequestAuthorActor `json:"-"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// The repository associated with this node.
+	Repository *getPullRequestDetailsNodePullRequestRepository `json:"repository"`
+	// Identifies the name of the base Ref associated with the pull request, even if the ref has been deleted.
+	BaseRefName string `json:"baseRefName"`
+	// Identifies the name of the head Ref associated with the pull request, even if the ref has been deleted.
+	HeadRefName string `json:"headRefName"`
+	// The number of additions in this pull request.
+	Additions int `json:"additions"`
+	// The number of deletions in this pull request.
+	Deletions int `json:"deletions"`
+	// The number of changed files in this pull request.
+	ChangedFiles int `json:"changedFiles"`
+	// A list of labels associated with the object.
+	Labels *getPullRequestDetailsNodePullRequestLabelsLabelConnection `json:"labels"`
+	// A list of Users assigned to this object.
+	Assignees *getPullRequestDetailsNodePullRequestAssigneesUserConnection `json:"assignees"`
+	// A list of latest reviews per user associated with the pull request that are not also pending review.
+	LatestReviews *getPullRequestDetailsNodePullRequestLatestReviewsPullRequestReviewConnection `json:"latestReviews"`
+	// A list of review requests associated with the pull request.
+	ReviewRequests *getPullRequestDetailsNodePullRequestReviewRequestsReviewRequestConnection `json:"reviewRequests"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequest.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetTypename() string { return v.Typename }
+
+// GetId returns getPullRequestDetailsNodePullRequest.Id, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetId() string { return v.Id }
+
+// GetNumber returns getPullRequestDetailsNodePullRequest.Number, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetNumber() int { return v.Number }
+
+// GetTitle returns getPullRequestDetailsNodePullRequest.Title, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetTitle() string { return v.Title }
+
+// GetBody returns getPullRequestDetailsNodePullRequest.Body, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetBody() string { return v.Body }
+
+// GetUrl returns getPullRequestDetailsNodePullRequest.Url, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetUrl() string { return v.Url }
+
+// GetIsDraft returns getPullRequestDetailsNodePullRequest.IsDraft, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetIsDraft() bool { return v.IsDraft }
+
+// GetAuthor returns getPullRequestDetailsNodePullRequest.Author, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetAuthor() getPullRequestDetailsNodePullRequestAuthorActor {
+	return v.Author
+}
+
+// GetCreatedAt returns getPullRequestDetailsNodePullRequest.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetRepository returns getPullRequestDetailsNodePullRequest.Repository, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetRepository() *getPullRequestDetailsNodePullRequestRepository {
+	return v.Repository
+}
+
+// GetBaseRefName returns getPullRequestDetailsNodePullRequest.BaseRefName, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetBaseRefName() string { return v.BaseRefName }
+
+// GetHeadRefName returns getPullRequestDetailsNodePullRequest.HeadRefName, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetHeadRefName() string { return v.HeadRefName }
+
+// GetAdditions returns getPullRequestDetailsNodePullRequest.Additions, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetAdditions() int { return v.Additions }
+
+// GetDeletions returns getPullRequestDetailsNodePullRequest.Deletions, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetDeletions() int { return v.Deletions }
+
+// GetChangedFiles returns getPullRequestDetailsNodePullRequest.ChangedFiles, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetChangedFiles() int { return v.ChangedFiles }
+
+// GetLabels returns getPullRequestDetailsNodePullRequest.Labels, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetLabels() *getPullRequestDetailsNodePullRequestLabelsLabelConnection {
+	return v.Labels
+}
+
+// GetAssignees returns getPullRequestDetailsNodePullRequest.Assignees, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetAssignees() *getPullRequestDetailsNodePullRequestAssigneesUserConnection {
+	return v.Assignees
+}
+
+// GetLatestReviews returns getPullRequestDetailsNodePullRequest.LatestReviews, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetLatestReviews() *getPullRequestDetailsNodePullRequestLatestReviewsPullRequestReviewConnection {
+	return v.LatestReviews
+}
+
+// GetReviewRequests returns getPullRequestDetailsNodePullRequest.ReviewRequests, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequest) GetReviewRequests() *getPullRequestDetailsNodePullRequestReviewRequestsReviewRequestConnection {
+	return v.ReviewRequests
+}
+
+func (v *getPullRequestDetailsNodePullRequest) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getPullRequestDetailsNodePullRequest
+		Author json.RawMessage `json:"author"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getPullRequestDetailsNodePullRequest = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Author
+		src := firstPass.Author
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetPullRequestDetailsNodePullRequestAuthorActor(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal getPullRequestDetailsNodePullRequest.Author: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetPullRequestDetailsNodePullRequest struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Number int `json:"number"`
+
+	Title string `json:"title"`
+
+	Body string `json:"body"`
+
+	Url string `json:"url"`
+
+	IsDraft bool `json:"isDraft"`
+
+	Author json.RawMessage `json:"author"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	Repository *getPullRequestDetailsNodePullRequestRepository `json:"repository"`
+
+	BaseRefName string `json:"baseRefName"`
+
+	HeadRefName string `json:"headRefName"`
+
+	Additions int `json:"additions"`
+
+	Deletions int `json:"deletions"`
+
+	ChangedFiles int `json:"changedFiles"`
+
+	Labels *getPullRequestDetailsNodePullRequestLabelsLabelConnection `json:"labels"`
+
+	Assignees *getPullRequestDetailsNodePullRequestAssigneesUserConnection `json:"assignees"`
+
+	LatestReviews *getPullRequestDetailsNodePullRequestLatestReviewsPullRequestReviewConnection `json:"latestReviews"`
+
+	ReviewRequests *getPullRequestDetailsNodePullRequestReviewRequestsReviewRequestConnection `json:"reviewRequests"`
+}
+
+func (v *getPullRequestDetailsNodePullRequest) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getPullRequestDetailsNodePullRequest) __premarshalJSON() (*__premarshalgetPullRequestDetailsNodePullRequest, error) {
+	var retval __premarshalgetPullRequestDetailsNodePullRequest
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Number = v.Number
+	retval.Title = v.Title
+	retval.Body = v.Body
+	retval.Url = v.Url
+	retval.IsDraft = v.IsDraft
+	{
+
+		dst := &retval.Author
+		src := v.Author
+		var err error
+		*dst, err = __marshalgetPullRequestDetailsNodePullRequestAuthorActor(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal getPullRequestDetailsNodePullRequest.Author: %w", err)
+		}
+	}
+	retval.CreatedAt = v.CreatedAt
+	retval.Repository = v.Repository
+	retval.BaseRefName = v.BaseRefName
+	retval.HeadRefName = v.HeadRefName
+	retval.Additions = v.Additions
+	retval.Deletions = v.Deletions
+	retval.ChangedFiles = v.ChangedFiles
+	retval.Labels = v.Labels
+	retval.Assignees = v.Assignees
+	retval.LatestReviews = v.LatestReviews
+	retval.ReviewRequests = v.ReviewRequests
+	return &retval, nil
+}
+
+// getPullRequestDetailsNodePullRequestAssigneesUserConnection includes the requested fields of the GraphQL type UserConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for User.
+type getPullRequestDetailsNodePullRequestAssigneesUserConnection struct {
+	// A list of nodes.
+	Nodes []*getPullRequestDetailsNodePullRequestAssigneesUserConnectionNodesUser `json:"nodes"`
+}
+
+// GetNodes returns getPullRequestDetailsNodePullRequestAssigneesUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAssigneesUserConnection) GetNodes() []*getPullRequestDetailsNodePullRequestAssigneesUserConnectionNodesUser {
+	return v.Nodes
+}
+
+// getPullRequestDetailsNodePullRequestAssigneesUserConnectionNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getPullRequestDetailsNodePullRequestAssigneesUserConnectionNodesUser struct {
+	// The username used to login.
+	Login string `json:"login"`
+}
+
+// GetLogin returns getPullRequestDetailsNodePullRequestAssigneesUserConnectionNodesUser.Login, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAssigneesUserConnectionNodesUser) GetLogin() string {
+	return v.Login
+}
+
+// getPullRequestDetailsNodePullRequestAuthorActor includes the requested fields of the GraphQL interface Actor.
+//
+// getPullRequestDetailsNodePullRequestAuthorActor is implemented by the following types:
+// getPullRequestDetailsNodePullRequestAuthorBot
+// getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount
+// getPullRequestDetailsNodePullRequestAuthorMannequin
+// getPullRequestDetailsNodePullRequestAuthorOrganization
+// getPullRequestDetailsNodePullRequestAuthorUser
+// The GraphQL type's documentation follows.
+//
+// Represents an object which can take actions on GitHub. Typically a User or Bot.
+type getPullRequestDetailsNodePullRequestAuthorActor interface {
+	implementsGraphQLInterfacegetPullRequestDetailsNodePullRequestAuthorActor()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetLogin returns the interface-field "login" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The username of the actor.
+	GetLogin() string
+}
+
+func (v *getPullRequestDetailsNodePullRequestAuthorBot) implementsGraphQLInterfacegetPullRequestDetailsNodePullRequestAuthorActor() {
+}
+func (v *getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount) implementsGraphQLInterfacegetPullRequestDetailsNodePullRequestAuthorActor() {
+}
+func (v *getPullRequestDetailsNodePullRequestAuthorMannequin) implementsGraphQLInterfacegetPullRequestDetailsNodePullRequestAuthorActor() {
+}
+func (v *getPullRequestDetailsNodePullRequestAuthorOrganization) implementsGraphQLInterfacegetPullRequestDetailsNodePullRequestAuthorActor() {
+}
+func (v *getPullRequestDetailsNodePullRequestAuthorUser) implementsGraphQLInterfacegetPullRequestDetailsNodePullRequestAuthorActor() {
+}
+
+func __unmarshalgetPullRequestDetailsNodePullRequestAuthorActor(b []byte, v *getPullRequestDetailsNodePullRequestAuthorActor) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Bot":
+		*v = new(getPullRequestDetailsNodePullRequestAuthorBot)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseUserAccount":
+		*v = new(getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount)
+		return json.Unmarshal(b, *v)
+	case "Mannequin":
+		*v = new(getPullRequestDetailsNodePullRequestAuthorMannequin)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(getPullRequestDetailsNodePullRequestAuthorOrganization)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(getPullRequestDetailsNodePullRequestAuthorUser)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Actor.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getPullRequestDetailsNodePullRequestAuthorActor: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetPullRequestDetailsNodePullRequestAuthorActor(v *getPullRequestDetailsNodePullRequestAuthorActor) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getPullRequestDetailsNodePullRequestAuthorBot:
+		typename = "Bot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestAuthorBot
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount:
+		typename = "EnterpriseUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestAuthorMannequin:
+		typename = "Mannequin"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestAuthorMannequin
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestAuthorOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestAuthorOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestDetailsNodePullRequestAuthorUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestDetailsNodePullRequestAuthorUser
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getPullRequestDetailsNodePullRequestAuthorActor: "%T"`, v)
+	}
+}
+
+// getPullRequestDetailsNodePullRequestAuthorBot includes the requested fields of the GraphQL type Bot.
+// The GraphQL type's documentation follows.
+//
+// A special type of user which takes actions on behalf of GitHub Apps.
+type getPullRequestDetailsNodePullRequestAuthorBot struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestAuthorBot.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorBot) GetTypename() string { return v.Typename }
+
+// GetLogin returns getPullRequestDetailsNodePullRequestAuthorBot.Login, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorBot) GetLogin() string { return v.Login }
+
+// getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// The GraphQL type's documentation follows.
+//
+// An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
+type getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount) GetTypename() string {
+	return v.Typename
+}
+
+// GetLogin returns getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount.Login, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorEnterpriseUserAccount) GetLogin() string {
+	return v.Login
+}
+
+// getPullRequestDetailsNodePullRequestAuthorMannequin includes the requested fields of the GraphQL type Mannequin.
+// The GraphQL type's documentation follows.
+//
+// A placeholder user for attribution of imported data on GitHub.
+type getPullRequestDetailsNodePullRequestAuthorMannequin struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestAuthorMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorMannequin) GetTypename() string { return v.Typename }
+
+// GetLogin returns getPullRequestDetailsNodePullRequestAuthorMannequin.Login, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorMannequin) GetLogin() string { return v.Login }
+
+// getPullRequestDetailsNodePullRequestAuthorOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getPullRequestDetailsNodePullRequestAuthorOrganization struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestAuthorOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorOrganization) GetTypename() string {
+	return v.Typename
+}
+
+// GetLogin returns getPullRequestDetailsNodePullRequestAuthorOrganization.Login, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorOrganization) GetLogin() string { return v.Login }
+
+// getPullRequestDetailsNodePullRequestAuthorUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getPullRequestDetailsNodePullRequestAuthorUser struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestAuthorUser.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorUser) GetTypename() string { return v.Typename }
+
+// GetLogin returns getPullRequestDetailsNodePullRequestAuthorUser.Login, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestAuthorUser) GetLogin() string { return v.Login }
+
+// getPullRequestDetailsNodePullRequestCommit includes the requested fields of the GraphQL type PullRequestCommit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit part of a pull request.
+type getPullRequestDetailsNodePullRequestCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestCommit) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePullRequestCommitCommentThread includes the requested fields of the GraphQL type PullRequestCommitCommentThread.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit comment thread part of a pull request.
+type getPullRequestDetailsNodePullRequestCommitCommentThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestCommitCommentThread.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestCommitCommentThread) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodePullRequestLabelsLabelConnection includes the requested fields of the GraphQL type LabelConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Label.
+type getPullRequestDetailsNodePullRequestLabelsLabelConnection struct {
+	// A list of nodes.
+	Nodes []*getPullRequestDetailsNodePullRequestLabelsLabelConnectionNodesLabel `json:"nodes"`
+}
+
+// GetNodes returns getPullRequestDetailsNodePullRequestLabelsLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestLabelsLabelConnection) GetNodes() []*getPullRequestDetailsNodePullRequestLabelsLabelConnectionNodesLabel {
+	return v.Nodes
+}
+
+// getPullRequestDetailsNodePullRequestLabelsLabelConnectionNodesLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type getPullRequestDetailsNodePullRequestLabelsLabelConnectionNodesLabel struct {
+	// Identifies the label name.
+	Name string `json:"name"`
+	// Identifies the label color.
+	Color string `json:"color"`
+}
+
+// GetName returns getPullRequestDetailsNodePullRequestLabelsLabelConnectionNodesLabel.Name, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestLabelsLabelConnectionNodesLabel) GetName() string {
+	return v.Name
+}
+
+// GetColor returns getPullRequestDetailsNodePullRequestLabelsLabelConnectionNodesLabel.Color, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestLabelsLabelConnectionNodesLabel) GetColor() string {
+	return v.Color
+}
+
+// getPullRequestDetailsNodePullRequestLatestReviewsPullRequestReviewConnection includes the requested fields of the GraphQL type PullRequestReviewConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequestReview.
+type getPullRequestDetailsNodePullRequestLatestReviewsPullRequestReviewConnection struct {
+	// A list of nodes.
+	Nodes []*PullRequestReviewFields `json:"nodes"`
+}
+
+// GetNodes returns getPullRequestDetailsNodePullRequestLatestReviewsPullRequestReviewConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestLatestReviewsPullRequestReviewConnection) GetNodes() []*PullRequestReviewFields {
+	return v.Nodes
+}
+
+// getPullRequestDetailsNodePullRequestRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestDetailsNodePullRequestRepository struct {
+	// The repository's name with owner.
+	NameWithOwner string `json:"nameWithOwner"`
+}
+
+// GetNameWithOwner returns getPullRequestDetailsNodePullRequestRepository.NameWithOwner, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestRepository) GetNameWithOwner() string {
+	return v.NameWithOwner
+}
+
+// getPullRequestDetailsNodePullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// The GraphQL type's documentation follows.
+//
+// A review object for a given pull request.
+type getPullRequestDetailsNodePullRequestReview struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestReview.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestReview) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePullRequestReviewComment includes the requested fields of the GraphQL type PullRequestReviewComment.
+// The GraphQL type's documentation follows.
+//
+// A review comment associated with a given repository pull request.
+type getPullRequestDetailsNodePullRequestReviewComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestReviewComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestReviewComment) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePullRequestReviewRequestsReviewRequestConnection includes the requested fields of the GraphQL type ReviewRequestConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for ReviewRequest.
+type getPullRequestDetailsNodePullRequestReviewRequestsReviewRequestConnection struct {
+	// A list of nodes.
+	Nodes []*ReviewRequestFields `json:"nodes"`
+}
+
+// GetNodes returns getPullRequestDetailsNodePullRequestReviewRequestsReviewRequestConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestReviewRequestsReviewRequestConnection) GetNodes() []*ReviewRequestFields {
+	return v.Nodes
+}
+
+// getPullRequestDetailsNodePullRequestReviewThread includes the requested fields of the GraphQL type PullRequestReviewThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type getPullRequestDetailsNodePullRequestReviewThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestReviewThread.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestReviewThread) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePullRequestThread includes the requested fields of the GraphQL type PullRequestThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type getPullRequestDetailsNodePullRequestThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePullRequestThread.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePullRequestThread) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePush includes the requested fields of the GraphQL type Push.
+// The GraphQL type's documentation follows.
+//
+// A Git push.
+type getPullRequestDetailsNodePush struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePush.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePush) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodePushAllowance includes the requested fields of the GraphQL type PushAllowance.
+// The GraphQL type's documentation follows.
+//
+// A team, user, or app who has the ability to push to a protected branch.
+type getPullRequestDetailsNodePushAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodePushAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodePushAllowance) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeReaction includes the requested fields of the GraphQL type Reaction.
+// The GraphQL type's documentation follows.
+//
+// An emoji reaction to a particular piece of content.
+type getPullRequestDetailsNodeReaction struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReaction.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReaction) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeReadyForReviewEvent includes the requested fields of the GraphQL type ReadyForReviewEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'ready_for_review' event on a given pull request.
+type getPullRequestDetailsNodeReadyForReviewEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReadyForReviewEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReadyForReviewEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type getPullRequestDetailsNodeRef struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRef.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRef) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeReferencedEvent includes the requested fields of the GraphQL type ReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'referenced' event on a given `ReferencedSubject`.
+type getPullRequestDetailsNodeReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReferencedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRelease includes the requested fields of the GraphQL type Release.
+// The GraphQL type's documentation follows.
+//
+// A release contains the content for a release.
+type getPullRequestDetailsNodeRelease struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRelease.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRelease) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeReleaseAsset includes the requested fields of the GraphQL type ReleaseAsset.
+// The GraphQL type's documentation follows.
+//
+// A release asset contains the content for a release asset.
+type getPullRequestDetailsNodeReleaseAsset struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReleaseAsset.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReleaseAsset) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRemovedFromProjectEvent includes the requested fields of the GraphQL type RemovedFromProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'removed_from_project' event on a given issue or pull request.
+type getPullRequestDetailsNodeRemovedFromProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRemovedFromProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRemovedFromProjectEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRenamedTitleEvent includes the requested fields of the GraphQL type RenamedTitleEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'renamed' event on a given issue or pull request
+type getPullRequestDetailsNodeRenamedTitleEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRenamedTitleEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRenamedTitleEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeReopenedEvent includes the requested fields of the GraphQL type ReopenedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'reopened' event on any `Closable`.
+type getPullRequestDetailsNodeReopenedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReopenedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReopenedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepoAccessAuditEntry includes the requested fields of the GraphQL type RepoAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.access event.
+type getPullRequestDetailsNodeRepoAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoAccessAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepoAddMemberAuditEntry includes the requested fields of the GraphQL type RepoAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.add_member event.
+type getPullRequestDetailsNodeRepoAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoAddMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepoAddTopicAuditEntry includes the requested fields of the GraphQL type RepoAddTopicAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.add_topic event.
+type getPullRequestDetailsNodeRepoAddTopicAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoAddTopicAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoAddTopicAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepoArchivedAuditEntry includes the requested fields of the GraphQL type RepoArchivedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.archived event.
+type getPullRequestDetailsNodeRepoArchivedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoArchivedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoArchivedAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepoChangeMergeSettingAuditEntry includes the requested fields of the GraphQL type RepoChangeMergeSettingAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.change_merge_setting event.
+type getPullRequestDetailsNodeRepoChangeMergeSettingAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoChangeMergeSettingAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoChangeMergeSettingAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigDisableAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_anonymous_git_access event.
+type getPullRequestDetailsNodeRepoConfigDisableAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigDisableAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigDisableAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigDisableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_collaborators_only event.
+type getPullRequestDetailsNodeRepoConfigDisableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigDisableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigDisableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigDisableContributorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableContributorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_contributors_only event.
+type getPullRequestDetailsNodeRepoConfigDisableContributorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigDisableContributorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigDisableContributorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigDisableSockpuppetDisallowedAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableSockpuppetDisallowedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_sockpuppet_disallowed event.
+type getPullRequestDetailsNodeRepoConfigDisableSockpuppetDisallowedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigDisableSockpuppetDisallowedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigDisableSockpuppetDisallowedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigEnableAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_anonymous_git_access event.
+type getPullRequestDetailsNodeRepoConfigEnableAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigEnableAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigEnableAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigEnableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_collaborators_only event.
+type getPullRequestDetailsNodeRepoConfigEnableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigEnableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigEnableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigEnableContributorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableContributorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_contributors_only event.
+type getPullRequestDetailsNodeRepoConfigEnableContributorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigEnableContributorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigEnableContributorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigEnableSockpuppetDisallowedAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableSockpuppetDisallowedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_sockpuppet_disallowed event.
+type getPullRequestDetailsNodeRepoConfigEnableSockpuppetDisallowedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigEnableSockpuppetDisallowedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigEnableSockpuppetDisallowedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigLockAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigLockAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.lock_anonymous_git_access event.
+type getPullRequestDetailsNodeRepoConfigLockAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigLockAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigLockAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoConfigUnlockAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigUnlockAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.unlock_anonymous_git_access event.
+type getPullRequestDetailsNodeRepoConfigUnlockAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoConfigUnlockAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoConfigUnlockAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepoCreateAuditEntry includes the requested fields of the GraphQL type RepoCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.create event.
+type getPullRequestDetailsNodeRepoCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoCreateAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepoDestroyAuditEntry includes the requested fields of the GraphQL type RepoDestroyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.destroy event.
+type getPullRequestDetailsNodeRepoDestroyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoDestroyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoDestroyAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepoRemoveMemberAuditEntry includes the requested fields of the GraphQL type RepoRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.remove_member event.
+type getPullRequestDetailsNodeRepoRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoRemoveMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepoRemoveTopicAuditEntry includes the requested fields of the GraphQL type RepoRemoveTopicAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.remove_topic event.
+type getPullRequestDetailsNodeRepoRemoveTopicAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepoRemoveTopicAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepoRemoveTopicAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestDetailsNodeRepository struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepository.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepository) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepositoryInvitation includes the requested fields of the GraphQL type RepositoryInvitation.
+// The GraphQL type's documentation follows.
+//
+// An invitation for a user to be added to a repository.
+type getPullRequestDetailsNodeRepositoryInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepositoryInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepositoryInvitation) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepositoryMigration includes the requested fields of the GraphQL type RepositoryMigration.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) repository migration.
+type getPullRequestDetailsNodeRepositoryMigration struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepositoryMigration.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepositoryMigration) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepositoryTopic includes the requested fields of the GraphQL type RepositoryTopic.
+// The GraphQL type's documentation follows.
+//
+// A repository-topic connects a repository to a topic.
+type getPullRequestDetailsNodeRepositoryTopic struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepositoryTopic.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepositoryTopic) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeRepositoryVisibilityChangeDisableAuditEntry includes the requested fields of the GraphQL type RepositoryVisibilityChangeDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repository_visibility_change.disable event.
+type getPullRequestDetailsNodeRepositoryVisibilityChangeDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepositoryVisibilityChangeDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepositoryVisibilityChangeDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepositoryVisibilityChangeEnableAuditEntry includes the requested fields of the GraphQL type RepositoryVisibilityChangeEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repository_visibility_change.enable event.
+type getPullRequestDetailsNodeRepositoryVisibilityChangeEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepositoryVisibilityChangeEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepositoryVisibilityChangeEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeRepositoryVulnerabilityAlert includes the requested fields of the GraphQL type RepositoryVulnerabilityAlert.
+// The GraphQL type's documentation follows.
+//
+// A Dependabot alert for a repository with a dependency affected by a security vulnerability.
+type getPullRequestDetailsNodeRepositoryVulnerabilityAlert struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeRepositoryVulnerabilityAlert.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeRepositoryVulnerabilityAlert) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeReviewDismissalAllowance includes the requested fields of the GraphQL type ReviewDismissalAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to dismiss a review on a protected branch.
+type getPullRequestDetailsNodeReviewDismissalAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReviewDismissalAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReviewDismissalAllowance) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeReviewDismissedEvent includes the requested fields of the GraphQL type ReviewDismissedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'review_dismissed' event on a given issue or pull request.
+type getPullRequestDetailsNodeReviewDismissedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReviewDismissedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReviewDismissedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeReviewRequest includes the requested fields of the GraphQL type ReviewRequest.
+// The GraphQL type's documentation follows.
+//
+// A request for a user to review a pull request.
+type getPullRequestDetailsNodeReviewRequest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReviewRequest.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReviewRequest) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeReviewRequestRemovedEvent includes the requested fields of the GraphQL type ReviewRequestRemovedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_request_removed' event on a given pull request.
+type getPullRequestDetailsNodeReviewRequestRemovedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReviewRequestRemovedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReviewRequestRemovedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeReviewRequestedEvent includes the requested fields of the GraphQL type ReviewRequestedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_requested' event on a given pull request.
+type getPullRequestDetailsNodeReviewRequestedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeReviewRequestedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeReviewRequestedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeSavedReply includes the requested fields of the GraphQL type SavedReply.
+// The GraphQL type's documentation follows.
+//
+// A Saved Reply is text a user can use to reply quickly.
+type getPullRequestDetailsNodeSavedReply struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeSavedReply.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeSavedReply) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeSecurityAdvisory includes the requested fields of the GraphQL type SecurityAdvisory.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Security Advisory
+type getPullRequestDetailsNodeSecurityAdvisory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeSecurityAdvisory.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeSecurityAdvisory) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeSponsorsActivity includes the requested fields of the GraphQL type SponsorsActivity.
+// The GraphQL type's documentation follows.
+//
+// An event related to sponsorship activity.
+type getPullRequestDetailsNodeSponsorsActivity struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeSponsorsActivity.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeSponsorsActivity) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeSponsorsListing includes the requested fields of the GraphQL type SponsorsListing.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Sponsors listing.
+type getPullRequestDetailsNodeSponsorsListing struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeSponsorsListing.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeSponsorsListing) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeSponsorsListingFeaturedItem includes the requested fields of the GraphQL type SponsorsListingFeaturedItem.
+// The GraphQL type's documentation follows.
+//
+// A record that is promoted on a GitHub Sponsors profile.
+type getPullRequestDetailsNodeSponsorsListingFeaturedItem struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeSponsorsListingFeaturedItem.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeSponsorsListingFeaturedItem) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeSponsorsTier includes the requested fields of the GraphQL type SponsorsTier.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Sponsors tier associated with a GitHub Sponsors listing.
+type getPullRequestDetailsNodeSponsorsTier struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeSponsorsTier.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeSponsorsTier) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeSponsorship includes the requested fields of the GraphQL type Sponsorship.
+// The GraphQL type's documentation follows.
+//
+// A sponsorship relationship between a sponsor and a maintainer
+type getPullRequestDetailsNodeSponsorship struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeSponsorship.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeSponsorship) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeSponsorshipNewsletter includes the requested fields of the GraphQL type SponsorshipNewsletter.
+// The GraphQL type's documentation follows.
+//
+// An update sent to sponsors of a user or organization on GitHub Sponsors.
+type getPullRequestDetailsNodeSponsorshipNewsletter struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeSponsorshipNewsletter.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeSponsorshipNewsletter) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeStatus includes the requested fields of the GraphQL type Status.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit status.
+type getPullRequestDetailsNodeStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeStatus) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
+// The GraphQL type's documentation follows.
+//
+// Represents the rollup for both the check runs and status for a commit.
+type getPullRequestDetailsNodeStatusCheckRollup struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeStatusCheckRollup.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeStatusCheckRollup) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeStatusContext includes the requested fields of the GraphQL type StatusContext.
+// The GraphQL type's documentation follows.
+//
+// Represents an individual commit status context
+type getPullRequestDetailsNodeStatusContext struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeStatusContext.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeStatusContext) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeSubscribedEvent includes the requested fields of the GraphQL type SubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'subscribed' event on a given `Subscribable`.
+type getPullRequestDetailsNodeSubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeSubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeSubscribedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getPullRequestDetailsNodeTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTag.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTag) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// A team of users in an organization.
+type getPullRequestDetailsNodeTeam struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTeam.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTeam) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeTeamAddMemberAuditEntry includes the requested fields of the GraphQL type TeamAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.add_member event.
+type getPullRequestDetailsNodeTeamAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTeamAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTeamAddMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeTeamAddRepositoryAuditEntry includes the requested fields of the GraphQL type TeamAddRepositoryAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.add_repository event.
+type getPullRequestDetailsNodeTeamAddRepositoryAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTeamAddRepositoryAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTeamAddRepositoryAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeTeamChangeParentTeamAuditEntry includes the requested fields of the GraphQL type TeamChangeParentTeamAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.change_parent_team event.
+type getPullRequestDetailsNodeTeamChangeParentTeamAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTeamChangeParentTeamAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTeamChangeParentTeamAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeTeamDiscussion includes the requested fields of the GraphQL type TeamDiscussion.
+// The GraphQL type's documentation follows.
+//
+// A team discussion.
+type getPullRequestDetailsNodeTeamDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTeamDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTeamDiscussion) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeTeamDiscussionComment includes the requested fields of the GraphQL type TeamDiscussionComment.
+// The GraphQL type's documentation follows.
+//
+// A comment on a team discussion.
+type getPullRequestDetailsNodeTeamDiscussionComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTeamDiscussionComment.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTeamDiscussionComment) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeTeamRemoveMemberAuditEntry includes the requested fields of the GraphQL type TeamRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.remove_member event.
+type getPullRequestDetailsNodeTeamRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTeamRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTeamRemoveMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeTeamRemoveRepositoryAuditEntry includes the requested fields of the GraphQL type TeamRemoveRepositoryAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.remove_repository event.
+type getPullRequestDetailsNodeTeamRemoveRepositoryAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTeamRemoveRepositoryAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTeamRemoveRepositoryAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getPullRequestDetailsNodeTopic includes the requested fields of the GraphQL type Topic.
+// The GraphQL type's documentation follows.
+//
+// A topic aggregates entities that are related to a subject.
+type getPullRequestDetailsNodeTopic struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTopic.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTopic) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeTransferredEvent includes the requested fields of the GraphQL type TransferredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'transferred' event on a given issue or pull request.
+type getPullRequestDetailsNodeTransferredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTransferredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTransferredEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getPullRequestDetailsNodeTree struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeTree.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeTree) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUnassignedEvent includes the requested fields of the GraphQL type UnassignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unassigned' event on any assignable object.
+type getPullRequestDetailsNodeUnassignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUnassignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUnassignedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUnlabeledEvent includes the requested fields of the GraphQL type UnlabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlabeled' event on a given issue or pull request.
+type getPullRequestDetailsNodeUnlabeledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUnlabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUnlabeledEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUnlockedEvent includes the requested fields of the GraphQL type UnlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlocked' event on a given issue or pull request.
+type getPullRequestDetailsNodeUnlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUnlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUnlockedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUnmarkedAsDuplicateEvent includes the requested fields of the GraphQL type UnmarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unmarked_as_duplicate' event on a given issue or pull request.
+type getPullRequestDetailsNodeUnmarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUnmarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUnmarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUnpinnedEvent includes the requested fields of the GraphQL type UnpinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unpinned' event on a given issue or pull request.
+type getPullRequestDetailsNodeUnpinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUnpinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUnpinnedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUnsubscribedEvent includes the requested fields of the GraphQL type UnsubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unsubscribed' event on a given `Subscribable`.
+type getPullRequestDetailsNodeUnsubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUnsubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUnsubscribedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getPullRequestDetailsNodeUser struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUser.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUser) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUserBlockedEvent includes the requested fields of the GraphQL type UserBlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'user_blocked' event on a given user.
+type getPullRequestDetailsNodeUserBlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUserBlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUserBlockedEvent) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUserContentEdit includes the requested fields of the GraphQL type UserContentEdit.
+// The GraphQL type's documentation follows.
+//
+// An edit on user content
+type getPullRequestDetailsNodeUserContentEdit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUserContentEdit.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUserContentEdit) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeUserStatus includes the requested fields of the GraphQL type UserStatus.
+// The GraphQL type's documentation follows.
+//
+// The user's description of what they're currently doing.
+type getPullRequestDetailsNodeUserStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeUserStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeUserStatus) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeVerifiableDomain includes the requested fields of the GraphQL type VerifiableDomain.
+// The GraphQL type's documentation follows.
+//
+// A domain that can be verified or approved for an organization or an enterprise.
+type getPullRequestDetailsNodeVerifiableDomain struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeVerifiableDomain.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeVerifiableDomain) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeWorkflow includes the requested fields of the GraphQL type Workflow.
+// The GraphQL type's documentation follows.
+//
+// A workflow contains meta information about an Actions workflow file.
+type getPullRequestDetailsNodeWorkflow struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeWorkflow.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeWorkflow) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsNodeWorkflowRun includes the requested fields of the GraphQL type WorkflowRun.
+// The GraphQL type's documentation follows.
+//
+// A workflow run.
+type getPullRequestDetailsNodeWorkflowRun struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPullRequestDetailsNodeWorkflowRun.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsNodeWorkflowRun) GetTypename() string { return v.Typename }
+
+// getPullRequestDetailsResponse is returned by getPullRequestDetails on success.
+type getPullRequestDetailsResponse struct {
+	// Fetches an object given its ID.
+	Node getPullRequestDetailsNode `json:"-"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetNode returns getPullRequestDetailsResponse.Node, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsResponse) GetNode() getPullRequestDetailsNode { return v.Node }
+
+// GetRateLimit returns getPullRequestDetailsResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *getPullRequestDetailsResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+func (v *getPullRequestDetailsResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getPullRequestDetailsResponse
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getPullRequestDetailsResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetPullRequestDetailsNode(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal getPullRequestDetailsResponse.Node: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetPullRequestDetailsResponse struct {
+	Node json.RawMessage `json:"node"`
+
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+func (v *getPullRequestDetailsResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getPullRequestDetailsResponse) __premarshalJSON() (*__premarshalgetPullRequestDetailsResponse, error) {
+	var retval __premarshalgetPullRequestDetailsResponse
+
+	{
+
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalgetPullRequestDetailsNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal getPullRequestDetailsResponse.Node: %w", err)
+		}
+	}
+	retval.RateLimit = v.RateLimit
+	return &retval, nil
+}
+
+// getPullRequestLatestReviewsRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestLatestReviewsRepository struct {
+	// Returns a single pull request from the current repository by number.
+	PullRequest *getPullRequestLatestReviewsRepositoryPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns getPullRequestLatestReviewsRepository.PullRequest, and is useful for accessing the field via an interface.
+func (v *getPullRequestLatestReviewsRepository) GetPullRequest() *getPullRequestLatestReviewsRepositoryPullRequest {
+	return v.PullRequest
+}
+
+// getPullRequestLatestReviewsRepositoryPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPullRequestLatestReviewsRepositoryPullRequest struct {
+	// A list of latest reviews per user associated with the pull request that are not also pending review.
+	LatestReviews *getPullRequestLatestReviewsRepositoryPullRequestLatestReviewsPullRequestReviewConnection `json:"latestReviews"`
+}
+
+// GetLatestReviews returns getPullRequestLatestReviewsRepositoryPullRequest.LatestReviews, and is useful for accessing the field via an interface.
+func (v *getPullRequestLatestReviewsRepositoryPullRequest) GetLatestReviews() *getPullRequestLatestReviewsRepositoryPullRequestLatestReviewsPullRequestReviewConnection {
+	return v.LatestReviews
+}
+
+// getPullRequestLatestReviewsRepositoryPullRequestLatestReviewsPullRequestReviewConnection includes the requested fields of the GraphQL type PullRequestReviewConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequestReview.
+type getPullRequestLatestReviewsRepositoryPullRequestLatestReviewsPullRequestReviewConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfoFields `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []*PullRequestReviewFields `json:"nodes"`
+}
+
+// GetPageInfo returns getPullRequestLatestReviewsRepositoryPullRequestLatestReviewsPullRequestReviewConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getPullRequestLatestReviewsRepositoryPullRequestLatestReviewsPullRequestReviewConnection) GetPageInfo() *PageInfoFields {
+	return v.PageInfo
+}
+
+// GetNodes returns getPullRequestLatestReviewsRepositoryPullRequestLatestReviewsPullRequestReviewConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestLatestReviewsRepositoryPullRequestLatestReviewsPullRequestReviewConnection) GetNodes() []*PullRequestReviewFields {
+	return v.Nodes
+}
+
+// getPullRequestLatestReviewsResponse is returned by getPullRequestLatestReviews on success.
+type getPullRequestLatestReviewsResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository *getPullRequestLatestReviewsRepository `json:"repository"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetRepository returns getPullRequestLatestReviewsResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getPullRequestLatestReviewsResponse) GetRepository() *getPullRequestLatestReviewsRepository {
+	return v.Repository
+}
+
+// GetRateLimit returns getPullRequestLatestReviewsResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *getPullRequestLatestReviewsResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+// getPullRequestReviewRequestsRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestReviewRequestsRepository struct {
+	// Returns a single pull request from the current repository by number.
+	PullRequest *getPullRequestReviewRequestsRepositoryPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns getPullRequestReviewRequestsRepository.PullRequest, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewRequestsRepository) GetPullRequest() *getPullRequestReviewRequestsRepositoryPullRequest {
+	return v.PullRequest
+}
+
+// getPullRequestReviewRequestsRepositoryPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPullRequestReviewRequestsRepositoryPullRequest struct {
+	// A list of review requests associated with the pull request.
+	ReviewRequests *getPullRequestReviewRequestsRepositoryPullRequestReviewRequestsReviewRequestConnection `json:"reviewRequests"`
+}
+
+// GetReviewRequests returns getPullRequestReviewRequestsRepositoryPullRequest.ReviewRequests, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewRequestsRepositoryPullRequest) GetReviewRequests() *getPullRequestReviewRequestsRepositoryPullRequestReviewRequestsReviewRequestConnection {
+	return v.ReviewRequests
+}
+
+// getPullRequestReviewRequestsRepositoryPullRequestReviewRequestsReviewRequestConnection includes the requested fields of the GraphQL type ReviewRequestConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for ReviewRequest.
+type getPullRequestReviewRequestsRepositoryPullRequestReviewRequestsReviewRequestConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfoFields `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []*ReviewRequestFields `json:"nodes"`
+}
+
+// GetPageInfo returns getPullRequestReviewRequestsRepositoryPullRequestReviewRequestsReviewRequestConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewRequestsRepositoryPullRequestReviewRequestsReviewRequestConnection) GetPageInfo() *PageInfoFields {
+	return v.PageInfo
+}
+
+// GetNodes returns getPullRequestReviewRequestsRepositoryPullRequestReviewRequestsReviewRequestConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewRequestsRepositoryPullRequestReviewRequestsReviewRequestConnection) GetNodes() []*ReviewRequestFields {
+	return v.Nodes
+}
+
+// getPullRequestReviewRequestsResponse is returned by getPullRequestReviewRequests on success.
+type getPullRequestReviewRequestsResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository *getPullRequestReviewRequestsRepository `json:"repository"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetRepository returns getPullRequestReviewRequestsResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewRequestsResponse) GetRepository() *getPullRequestReviewRequestsRepository {
+	return v.Repository
+}
+
+// GetRateLimit returns getPullRequestReviewRequestsResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewRequestsResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+// getPullRequestReviewThreadsRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestReviewThreadsRepository struct {
+	// Returns a single pull request from the current repository by number.
+	PullRequest *getPullRequestReviewThreadsRepositoryPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns getPullRequestReviewThreadsRepository.PullRequest, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewThreadsRepository) GetPullRequest() *getPullRequestReviewThreadsRepositoryPullRequest {
+	return v.PullRequest
+}
+
+// getPullRequestReviewThreadsRepositoryPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPullRequestReviewThreadsRepositoryPullRequest struct {
+	// The list of all review threads for this pull request.
+	ReviewThreads *getPullRequestReviewThreadsRepositoryPullRequestReviewThreadsPullRequestReviewThreadConnection `json:"reviewThreads"`
+}
+
+// GetReviewThreads returns getPullRequestReviewThreadsRepositoryPullRequest.ReviewThreads, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewThreadsRepositoryPullRequest) GetReviewThreads() *getPullRequestReviewThreadsRepositoryPullRequestReviewThreadsPullRequestReviewThreadConnection {
+	return v.ReviewThreads
+}
+
+// getPullRequestReviewThreadsRepositoryPullRequestReviewThreadsPullRequestReviewThreadConnection includes the requested fields of the GraphQL type PullRequestReviewThreadConnection.
+// The GraphQL type's documentation follows.
+//
+// Review comment threads for a pull request review.
+type getPullRequestReviewThreadsRepositoryPullRequestReviewThreadsPullRequestReviewThreadConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfoFields `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []*ReviewThreadFields `json:"nodes"`
+}
+
+// GetPageInfo returns getPullRequestReviewThreadsRepositoryPullRequestReviewThreadsPullRequestReviewThreadConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewThreadsRepositoryPullRequestReviewThreadsPullRequestReviewThreadConnection) GetPageInfo() *PageInfoFields {
+	return v.PageInfo
+}
+
+// GetNodes returns getPullRequestReviewThreadsRepositoryPullRequestReviewThreadsPullRequestReviewThreadConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewThreadsRepositoryPullRequestReviewThreadsPullRequestReviewThreadConnection) GetNodes() []*ReviewThreadFields {
+	return v.Nodes
+}
+
+// getPullRequestReviewThreadsResponse is returned by getPullRequestReviewThreads on success.
+type getPullRequestReviewThreadsResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository *getPullRequestReviewThreadsRepository `json:"repository"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetRepository returns getPullRequestReviewThreadsResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewThreadsResponse) GetRepository() *getPullRequestReviewThreadsRepository {
+	return v.Repository
+}
+
+// GetRateLimit returns getPullRequestReviewThreadsResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *getPullRequestReviewThreadsResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+// getRepositoryInfoRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getRepositoryInfoRepository struct {
+	// A list of pull requests that have been opened in the repository.
+	PullRequests *getRepositoryInfoRepositoryPullRequestsPullRequestConnection `json:"pullRequests"`
+}
+
+// GetPullRequests returns getRepositoryInfoRepository.PullRequests, and is useful for accessing the field via an interface.
+func (v *getRepositoryInfoRepository) GetPullRequests() *getRepositoryInfoRepositoryPullRequestsPullRequestConnection {
+	return v.PullRequests
+}
+
+// getRepositoryInfoRepositoryPullRequestsPullRequestConnection includes the requested fields of the GraphQL type PullRequestConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequest.
+type getRepositoryInfoRepositoryPullRequestsPullRequestConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfoFields `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []*PullRequestFields `json:"nodes"`
+}
+
+// GetPageInfo returns getRepositoryInfoRepositoryPullRequestsPullRequestConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnection) GetPageInfo() *PageInfoFields {
+	return v.PageInfo
+}
+
+// GetNodes returns getRepositoryInfoRepositoryPullRequestsPullRequestConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getRepositoryInfoRepositoryPullRequestsPullRequestConnection) GetNodes() []*PullRequestFields {
+	return v.Nodes
+}
+
+// getRepositoryInfoResponse is returned by getRepositoryInfo on success.
+type getRepositoryInfoResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository *getRepositoryInfoRepository `json:"repository"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetRepository returns getRepositoryInfoResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getRepositoryInfoResponse) GetRepository() *getRepositoryInfoRepository { return v.Repository }
+
+// GetRateLimit returns getRepositoryInfoResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *getRepositoryInfoResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+// getViewerResponse is returned by getViewer on success.
+type getViewerResponse struct {
+	// The currently authenticated user.
+	Viewer *getViewerViewerUser `json:"viewer"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetViewer returns getViewerResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *getViewerResponse) GetViewer() *getViewerViewerUser { return v.Viewer }
+
+// GetRateLimit returns getViewerResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *getViewerResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+// getViewerTeamsResponse is returned by getViewerTeams on success.
+type getViewerTeamsResponse struct {
+	// The currently authenticated user.
+	Viewer *getViewerTeamsViewerUser `json:"viewer"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetViewer returns getViewerTeamsResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *getViewerTeamsResponse) GetViewer() *getViewerTeamsViewerUser { return v.Viewer }
+
+// GetRateLimit returns getViewerTeamsResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *getViewerTeamsResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+// getViewerTeamsViewerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getViewerTeamsViewerUser struct {
+	// A list of organizations the user belongs to.
+	Organizations *getViewerTeamsViewerUserOrganizationsOrganizationConnection `json:"organizations"`
+}
+
+// GetOrganizations returns getViewerTeamsViewerUser.Organizations, and is useful for accessing the field via an interface.
+func (v *getViewerTeamsViewerUser) GetOrganizations() *getViewerTeamsViewerUserOrganizationsOrganizationConnection {
+	return v.Organizations
+}
+
+// getViewerTeamsViewerUserOrganizationsOrganizationConnection includes the requested fields of the GraphQL type OrganizationConnection.
+// The GraphQL type's documentation follows.
+//
+// A list of organizations managed by an enterprise.
+type getViewerTeamsViewerUserOrganizationsOrganizationConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfoFields `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []*getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganization `json:"nodes"`
+}
+
+// GetPageInfo returns getViewerTeamsViewerUserOrganizationsOrganizationConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getViewerTeamsViewerUserOrganizationsOrganizationConnection) GetPageInfo() *PageInfoFields {
+	return v.PageInfo
+}
+
+// GetNodes returns getViewerTeamsViewerUserOrganizationsOrganizationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getViewerTeamsViewerUserOrganizationsOrganizationConnection) GetNodes() []*getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganization {
+	return v.Nodes
+}
+
+// getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganization struct {
+	// A list of teams in this organization.
+	Teams *getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnection `json:"teams"`
+}
+
+// GetTeams returns getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganization.Teams, and is useful for accessing the field via an interface.
+func (v *getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganization) GetTeams() *getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnection {
+	return v.Teams
+}
+
+// getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Team.
+type getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnection struct {
+	// A list of nodes.
+	Nodes []*getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnectionNodesTeam `json:"nodes"`
+}
+
+// GetNodes returns getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnection) GetNodes() []*getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnectionNodesTeam {
+	return v.Nodes
+}
+
+// getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnectionNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// A team of users in an organization.
+type getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnectionNodesTeam struct {
+	// The slug corresponding to the organization and team.
+	CombinedSlug string `json:"combinedSlug"`
+}
+
+// GetCombinedSlug returns getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnectionNodesTeam.CombinedSlug, and is useful for accessing the field via an interface.
+func (v *getViewerTeamsViewerUserOrganizationsOrganizationConnectionNodesOrganizationTeamsTeamConnectionNodesTeam) GetCombinedSlug() string {
+	return v.CombinedSlug
+}
+
+// getViewerViewerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getViewerViewerUser struct {
+	// The username used to login.
+	Login string `json:"login"`
+}
+
+// GetLogin returns getViewerViewerUser.Login, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetLogin() string { return v.Login }
+
+// replyToReviewCommentAddPullRequestReviewCommentAddPullRequestReviewCommentPayload includes the requested fields of the GraphQL type AddPullRequestReviewCommentPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of AddPullRequestReviewComment
+type replyToReviewCommentAddPullRequestReviewCommentAddPullRequestReviewCommentPayload struct {
+	// The newly created comment.
+	Comment *ReviewCommentFields `json:"comment"`
+}
+
+// GetComment returns replyToReviewCommentAddPullRequestReviewCommentAddPullRequestReviewCommentPayload.Comment, and is useful for accessing the field via an interface.
+func (v *replyToReviewCommentAddPullRequestReviewCommentAddPullRequestReviewCommentPayload) GetComment() *ReviewCommentFields {
+	return v.Comment
+}
+
+// replyToReviewCommentResponse is returned by replyToReviewComment on success.
+type replyToReviewCommentResponse struct {
+	// Adds a comment to a review.
+	AddPullRequestReviewComment *replyToReviewCommentAddPullRequestReviewCommentAddPullRequestReviewCommentPayload `json:"addPullRequestReviewComment"`
+}
+
+// GetAddPullRequestReviewComment returns replyToReviewCommentResponse.AddPullRequestReviewComment, and is useful for accessing the field via an interface.
+func (v *replyToReviewCommentResponse) GetAddPullRequestReviewComment() *replyToReviewCommentAddPullRequestReviewCommentAddPullRequestReviewCommentPayload {
+	return v.AddPullRequestReviewComment
+}
+
+// rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayload includes the requested fields of the GraphQL type RerequestCheckSuitePayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of RerequestCheckSuite
+type rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayload struct {
+	// The requested check suite.
+	CheckSuite *rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayloadCheckSuite `json:"checkSuite"`
+}
+
+// GetCheckSuite returns rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayload.CheckSuite, and is useful for accessing the field via an interface.
+func (v *rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayload) GetCheckSuite() *rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayloadCheckSuite {
+	return v.CheckSuite
+}
+
+// rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayloadCheckSuite includes the requested fields of the GraphQL type CheckSuite.
+// The GraphQL type's documentation follows.
+//
+// A check suite.
+type rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayloadCheckSuite struct {
+	Id string `json:"id"`
+}
+
+// GetId returns rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayloadCheckSuite.Id, and is useful for accessing the field via an interface.
+func (v *rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayloadCheckSuite) GetId() string {
+	return v.Id
+}
+
+// rerequestCheckSuiteResponse is returned by rerequestCheckSuite on success.
+type rerequestCheckSuiteResponse struct {
+	// Rerequests an existing check suite.
+	RerequestCheckSuite *rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayload `json:"rerequestCheckSuite"`
+}
+
+// GetRerequestCheckSuite returns rerequestCheckSuiteResponse.RerequestCheckSuite, and is useful for accessing the field via an interface.
+func (v *rerequestCheckSuiteResponse) GetRerequestCheckSuite() *rerequestCheckSuiteRerequestCheckSuiteRerequestCheckSuitePayload {
+	return v.RerequestCheckSuite
+}
+
+// resolveReviewThreadResolveReviewThreadResolveReviewThreadPayload includes the requested fields of the GraphQL type ResolveReviewThreadPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of ResolveReviewThread
+type resolveReviewThreadResolveReviewThreadResolveReviewThreadPayload struct {
+	// The thread to resolve.
+	Thread *resolveReviewThreadResolveReviewThreadResolveReviewThreadPayloadThreadPullRequestReviewThread `json:"thread"`
+}
+
+// GetThread returns resolveReviewThreadResolveReviewThreadResolveReviewThreadPayload.Thread, and is useful for accessing the field via an interface.
+func (v *resolveReviewThreadResolveReviewThreadResolveReviewThreadPayload) GetThread() *resolveReviewThreadResolveReviewThreadResolveReviewThreadPayloadThreadPullRequestReviewThread {
+	return v.Thread
+}
+
+// resolveReviewThreadResolveReviewThreadResolveReviewThreadPayloadThreadPullRequestReviewThread includes the requested fields of the GraphQL type PullRequestReviewThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type resolveReviewThreadResolveReviewThreadResolveReviewThreadPayloadThreadPullRequestReviewThread struct {
+	Id string `json:"id"`
+	// Whether this thread has been resolved
+	IsResolved bool `json:"isResolved"`
+}
+
+// GetId returns resolveReviewThreadResolveReviewThreadResolveReviewThreadPayloadThreadPullRequestReviewThread.Id, and is useful for accessing the field via an interface.
+func (v *resolveReviewThreadResolveReviewThreadResolveReviewThreadPayloadThreadPullRequestReviewThread) GetId() string {
+	return v.Id
+}
+
+// GetIsResolved returns resolveReviewThreadResolveReviewThreadResolveReviewThreadPayloadThreadPullRequestReviewThread.IsResolved, and is useful for accessing the field via an interface.
+func (v *resolveReviewThreadResolveReviewThreadResolveReviewThreadPayloadThreadPullRequestReviewThread) GetIsResolved() bool {
+	return v.IsResolved
+}
+
+// resolveReviewThreadResponse is returned by resolveReviewThread on success.
+type resolveReviewThreadResponse struct {
+	// Marks a review thread as resolved.
+	ResolveReviewThread *resolveReviewThreadResolveReviewThreadResolveReviewThreadPayload `json:"resolveReviewThread"`
+}
+
+// GetResolveReviewThread returns resolveReviewThreadResponse.ResolveReviewThread, and is useful for accessing the field via an interface.
+func (v *resolveReviewThreadResponse) GetResolveReviewThread() *resolveReviewThreadResolveReviewThreadResolveReviewThreadPayload {
+	return v.ResolveReviewThread
+}
+
+// searchPullRequestsResponse is returned by searchPullRequests on success.
+type searchPullRequestsResponse struct {
+	// Perform a search across resources, returning a maximum of 1,000 results.
+	Search *searchPullRequestsSearchSearchResultItemConnection `json:"search"`
+	// The client's rate limit information.
+	RateLimit *RateLimitFields `json:"rateLimit"`
+}
+
+// GetSearch returns searchPullRequestsResponse.Search, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsResponse) GetSearch() *searchPullRequestsSearchSearchResultItemConnection {
+	return v.Search
+}
+
+// GetRateLimit returns searchPullRequestsResponse.RateLimit, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsResponse) GetRateLimit() *RateLimitFields { return v.RateLimit }
+
+// searchPullRequestsSearchSearchResultItemConnection includes the requested fields of the GraphQL type SearchResultItemConnection.
+// The GraphQL type's documentation follows.
+//
+// A list of results that matched against a search query. Regardless of the number
+// of matches, a maximum of 1,000 results will be available across all types,
+// potentially split across many pages.
+type searchPullRequestsSearchSearchResultItemConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfoFields `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem `json:"-"`
+}
+
+// GetPageInfo returns searchPullRequestsSearchSearchResultItemConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnection) GetPageInfo() *PageInfoFields {
+	return v.PageInfo
+}
+
+// GetNodes returns searchPullRequestsSearchSearchResultItemConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnection) GetNodes() []searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem {
+	return v.Nodes
+}
+
+func (v *searchPullRequestsSearchSearchResultItemConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*searchPullRequestsSearchSearchResultItemConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.searchPullRequestsSearchSearchResultItemConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalsearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal searchPullRequestsSearchSearchResultItemConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalsearchPullRequestsSearchSearchResultItemConnection struct {
+	PageInfo *PageInfoFields `json:"pageInfo"`
+
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *searchPullRequestsSearchSearchResultItemConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *searchPullRequestsSearchSearchResultItemConnection) __premarshalJSON() (*__premarshalsearchPullRequestsSearchSearchResultItemConnection, error) {
+	var retval __premarshalsearchPullRequestsSearchSearchResultItemConnection
+
+	retval.PageInfo = v.PageInfo
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalsearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal searchPullRequestsSearchSearchResultItemConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// searchPullRequestsSearchSearchResultItemConnectionNodesApp includes the requested fields of the GraphQL type App.
+// The GraphQL type's documentation follows.
+//
+// A GitHub App.
+type searchPullRequestsSearchSearchResultItemConnectionNodesApp struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns searchPullRequestsSearchSearchResultItemConnectionNodesApp.Typename, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesApp) GetTypename() string {
+	return v.Typename
+}
+
+// searchPullRequestsSearchSearchResultItemConnectionNodesDiscussion includes the requested fields of the GraphQL type Discussion.
+// The GraphQL type's documentation follows.
+//
+// A discussion in a repository.
+type searchPullRequestsSearchSearchResultItemConnectionNodesDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns searchPullRequestsSearchSearchResultItemConnectionNodesDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesDiscussion) GetTypename() string {
+	return v.Typename
+}
+
+// searchPullRequestsSearchSearchResultItemConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type searchPullRequestsSearchSearchResultItemConnectionNodesIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns searchPullRequestsSearchSearchResultItemConnectionNodesIssue.Typename, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesIssue) GetTypename() string {
+	return v.Typename
+}
+
+// searchPullRequestsSearchSearchResultItemConnectionNodesMarketplaceListing includes the requested fields of the GraphQL type MarketplaceListing.
+// The GraphQL type's documentation follows.
+//
+// A listing in the GitHub integration marketplace.
+type searchPullRequestsSearchSearchResultItemConnectionNodesMarketplaceListing struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns searchPullRequestsSearchSearchResultItemConnectionNodesMarketplaceListing.Typename, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesMarketplaceListing) GetTypename() string {
+	return v.Typename
+}
+
+// searchPullRequestsSearchSearchResultItemConnectionNodesOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type searchPullRequestsSearchSearchResultItemConnectionNodesOrganization struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns searchPullRequestsSearchSearchResultItemConnectionNodesOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesOrganization) GetTypename() string {
+	return v.Typename
+}
+
+// searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest struct {
+	Typename          string `json:"__typename"`
+	PullRequestFields `json:"-"`
+}
+
+// GetTypename returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Typename, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetTypename() string {
+	return v.Typename
+}
+
+// GetUrl returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Url, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetUrl() string {
+	return v.PullRequestFields.Url
+}
+
+// GetId returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Id, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetId() string {
+	return v.PullRequestFields.Id
+}
+
+// GetNumber returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Number, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetNumber() int {
+	return v.PullRequestFields.Number
+}
+
+// GetIsDraft returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.IsDraft, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetIsDraft() bool {
+	return v.PullRequestFields.IsDraft
+}
+
+// GetReviewDecision returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.ReviewDecision, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetReviewDecision() PullRequestReviewDecision {
+	return v.PullRequestFields.ReviewDecision
+}
+
+// GetRepository returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Repository, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetRepository() *PullRequestFieldsRepository {
+	return v.PullRequestFields.Repository
+}
+
+// GetAuthor returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Author, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetAuthor() PullRequestFieldsAuthorActor {
+	return v.PullRequestFields.Author
+}
+
+// GetCreatedAt returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.CreatedAt, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetCreatedAt() time.Time {
+	return v.PullRequestFields.CreatedAt
+}
+
+// GetUpdatedAt returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetUpdatedAt() time.Time {
+	return v.PullRequestFields.UpdatedAt
+}
+
+// GetAdditions returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Additions, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetAdditions() int {
+	return v.PullRequestFields.Additions
+}
+
+// GetDeletions returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Deletions, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetDeletions() int {
+	return v.PullRequestFields.Deletions
+}
+
+// GetHeadRefOid returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.HeadRefOid, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetHeadRefOid() string {
+	return v.PullRequestFields.HeadRefOid
+}
+
+// GetLabels returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Labels, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetLabels() *PullRequestFieldsLabelsLabelConnection {
+	return v.PullRequestFields.Labels
+}
+
+// GetCommits returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Commits, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetCommits() *PullRequestFieldsCommitsPullRequestCommitConnection {
+	return v.PullRequestFields.Commits
+}
+
+// GetLatestReviews returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.LatestReviews, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetLatestReviews() *PullRequestFieldsLatestReviewsPullRequestReviewConnection {
+	return v.PullRequestFields.LatestReviews
+}
+
+// GetTitle returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.Title, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetTitle() string {
+	return v.PullRequestFields.Title
+}
+
+// GetReviewRequests returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.ReviewRequests, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetReviewRequests() *PullRequestFieldsReviewRequestsReviewRequestConnection {
+	return v.PullRequestFields.ReviewRequests
+}
+
+// GetReviewThreads returns searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.ReviewThreads, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) GetReviewThreads() *PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection {
+	return v.PullRequestFields.ReviewThreads
+}
+
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PullRequestFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalsearchPullRequestsSearchSearchResultItemConnectionNodesPullRequest struct {
+	Typename string `json:"__typename"`
+
+	Url string `json:"url"`
+
+	Id string `json:"id"`
+
+	Number int `json:"number"`
+
+	IsDraft bool `json:"isDraft"`
+
+	ReviewDecision PullRequestReviewDecision `json:"reviewDecision"`
+
+	Repository *PullRequestFieldsRepository `json:"repository"`
+
+	Author json.RawMessage `json:"author"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Additions int `json:"additions"`
+
+	Deletions int `json:"deletions"`
+
+	HeadRefOid string `json:"headRefOid"`
+
+	Labels *PullRequestFieldsLabelsLabelConnection `json:"labels"`
+
+	Commits *PullRequestFieldsCommitsPullRequestCommitConnection `json:"commits"`
+
+	LatestReviews *PullRequestFieldsLatestReviewsPullRequestReviewConnection `json:"latestReviews"`
+
+	Title string `json:"title"`
+
+	ReviewRequests *PullRequestFieldsReviewRequestsReviewRequestConnection `json:"reviewRequests"`
+
+	ReviewThreads *PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection `json:"reviewThreads"`
+}
+
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) __premarshalJSON() (*__premarshalsearchPullRequestsSearchSearchResultItemConnectionNodesPullRequest, error) {
+	var retval __premarshalsearchPullRequestsSearchSearchResultItemConnectionNodesPullRequest
+
+	retval.Typename = v.Typename
+	retval.Url = v.PullRequestFields.Url
+	retval.Id = v.PullRequestFields.Id
+	retval.Number = v.PullRequestFields.Number
+	retval.IsDraft = v.PullRequestFields.IsDraft
+	retval.ReviewDecision = v.PullRequestFields.ReviewDecision
+	retval.Repository = v.PullRequestFields.Repository
+	{
+
+		dst := &retval.Author
+		src := v.PullRequestFields.Author
+		var err error
+		*dst, err = __marshalPullRequestFieldsAuthorActor(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest.PullRequestFields.Author: %w", err)
+		}
+	}
+	retval.CreatedAt = v.PullRequestFields.CreatedAt
+	retval.UpdatedAt = v.PullRequestFields.UpdatedAt
+	retval.Additions = v.PullRequestFields.Additions
+	retval.Deletions = v.PullRequestFields.Deletions
+	retval.HeadRefOid = v.PullRequestFields.HeadRefOid
+	retval.Labels = v.PullRequestFields.Labels
+	retval.Commits = v.PullRequestFields.Commits
+	retval.LatestReviews = v.PullRequestFields.LatestReviews
+	retval.Title = v.PullRequestFields.Title
+	retval.ReviewRequests = v.PullRequestFields.ReviewRequests
+	retval.ReviewThreads = v.PullRequestFields.ReviewThreads
+	return &retval, nil
+}
+
+// searchPullRequestsSearchSearchResultItemConnectionNodesRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type searchPullRequestsSearchSearchResultItemConnectionNodesRepository struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns searchPullRequestsSearchSearchResultItemConnectionNodesRepository.Typename, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesRepository) GetTypename() string {
+	return v.Typename
+}
+
+// searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem includes the requested fields of the GraphQL interface SearchResultItem.
+//
+// searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem is implemented by the following types:
+// searchPullRequestsSearchSearchResultItemConnectionNodesApp
+// searchPullRequestsSearchSearchResultItemConnectionNodesDiscussion
+// searchPullRequestsSearchSearchResultItemConnectionNodesIssue
+// searchPullRequestsSearchSearchResultItemConnectionNodesMarketplaceListing
+// searchPullRequestsSearchSearchResultItemConnectionNodesOrganization
+// searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest
+// searchPullRequestsSearchSearchResultItemConnectionNodesRepository
+// searchPullRequestsSearchSearchResultItemConnectionNodesUser
+// The GraphQL type's documentation follows.
+//
+// The results of a search.
+type searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem interface {
+	implementsGraphQLInterfacesearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesApp) implementsGraphQLInterfacesearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem() {
+}
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesDiscussion) implementsGraphQLInterfacesearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem() {
+}
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesIssue) implementsGraphQLInterfacesearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem() {
+}
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesMarketplaceListing) implementsGraphQLInterfacesearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem() {
+}
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesOrganization) implementsGraphQLInterfacesearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem() {
+}
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest) implementsGraphQLInterfacesearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem() {
+}
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesRepository) implementsGraphQLInterfacesearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem() {
+}
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesUser) implementsGraphQLInterfacesearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem() {
+}
+
+func __unmarshalsearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem(b []byte, v *searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "App":
+		*v = new(searchPullRequestsSearchSearchResultItemConnectionNodesApp)
+		return json.Unmarshal(b, *v)
+	case "Discussion":
+		*v = new(searchPullRequestsSearchSearchResultItemConnectionNodesDiscussion)
+		return json.Unmarshal(b, *v)
+	case "Issue":
+		*v = new(searchPullRequestsSearchSearchResultItemConnectionNodesIssue)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceListing":
+		*v = new(searchPullRequestsSearchSearchResultItemConnectionNodesMarketplaceListing)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(searchPullRequestsSearchSearchResultItemConnectionNodesOrganization)
+		return json.Unmarshal(b, *v)
+	case "PullRequest":
+		*v = new(searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest)
+		return json.Unmarshal(b, *v)
+	case "Repository":
+		*v = new(searchPullRequestsSearchSearchResultItemConnectionNodesRepository)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(searchPullRequestsSearchSearchResultItemConnectionNodesUser)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing SearchResultItem.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalsearchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem(v *searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *searchPullRequestsSearchSearchResultItemConnectionNodesApp:
+		typename = "App"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*searchPullRequestsSearchSearchResultItemConnectionNodesApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *searchPullRequestsSearchSearchResultItemConnectionNodesDiscussion:
+		typename = "Discussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*searchPullRequestsSearchSearchResultItemConnectionNodesDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *searchPullRequestsSearchSearchResultItemConnectionNodesIssue:
+		typename = "Issue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*searchPullRequestsSearchSearchResultItemConnectionNodesIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *searchPullRequestsSearchSearchResultItemConnectionNodesMarketplaceListing:
+		typename = "MarketplaceListing"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*searchPullRequestsSearchSearchResultItemConnectionNodesMarketplaceListing
+		}{typename, v}
+		return json.Marshal(result)
+	case *searchPullRequestsSearchSearchResultItemConnectionNodesOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*searchPullRequestsSearchSearchResultItemConnectionNodesOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest:
+		typename = "PullRequest"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalsearchPullRequestsSearchSearchResultItemConnectionNodesPullRequest
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *searchPullRequestsSearchSearchResultItemConnectionNodesRepository:
+		typename = "Repository"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*searchPullRequestsSearchSearchResultItemConnectionNodesRepository
+		}{typename, v}
+		return json.Marshal(result)
+	case *searchPullRequestsSearchSearchResultItemConnectionNodesUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*searchPullRequestsSearchSearchResultItemConnectionNodesUser
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for searchPullRequestsSearchSearchResultItemConnectionNodesSearchResultItem: "%T"`, v)
+	}
+}
+
+// searchPullRequestsSearchSearchResultItemConnectionNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type searchPullRequestsSearchSearchResultItemConnectionNodesUser struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns searchPullRequestsSearchSearchResultItemConnectionNodesUser.Typename, and is useful for accessing the field via an interface.
+func (v *searchPullRequestsSearchSearchResultItemConnectionNodesUser) GetTypename() string {
+	return v.Typename
+}
+
+// submitPullRequestReviewResponse is returned by submitPullRequestReview on success.
+type submitPullRequestReviewResponse struct {
+	// Submits a pending pull request review.
+	SubmitPullRequestReview *submitPullRequestReviewSubmitPullRequestReviewSubmitPullRequestReviewPayload `json:"submitPullRequestReview"`
+}
+
+// GetSubmitPullRequestReview returns submitPullRequestReviewResponse.SubmitPullRequestReview, and is useful for accessing the field via an interface.
+func (v *submitPullRequestReviewResponse) GetSubmitPullRequestReview() *submitPullRequestReviewSubmitPullRequestReviewSubmitPullRequestReviewPayload {
+	return v.SubmitPullRequestReview
+}
+
+// submitPullRequestReviewSubmitPullRequestReviewSubmitPullRequestReviewPayload includes the requested fields of the GraphQL type SubmitPullRequestReviewPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of SubmitPullRequestReview
+type submitPullRequestReviewSubmitPullRequestReviewSubmitPullRequestReviewPayload struct {
+	// The submitted pull request review.
+	PullRequestReview *PullRequestReviewFields `json:"pullRequestReview"`
+}
+
+// GetPullRequestReview returns submitPullRequestReviewSubmitPullRequestReviewSubmitPullRequestReviewPayload.PullRequestReview, and is useful for accessing the field via an interface.
+func (v *submitPullRequestReviewSubmitPullRequestReviewSubmitPullRequestReviewPayload) GetPullRequestReview() *PullRequestReviewFields {
+	return v.PullRequestReview
+}
+
+// unresolveReviewThreadResponse is returned by unresolveReviewThread on success.
+type unresolveReviewThreadResponse struct {
+	// Marks a review thread as unresolved.
+	UnresolveReviewThread *unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayload `json:"unresolveReviewThread"`
+}
+
+// GetUnresolveReviewThread returns unresolveReviewThreadResponse.UnresolveReviewThread, and is useful for accessing the field via an interface.
+func (v *unresolveReviewThreadResponse) GetUnresolveReviewThread() *unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayload {
+	return v.UnresolveReviewThread
+}
+
+// unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayload includes the requested fields of the GraphQL type UnresolveReviewThreadPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of UnresolveReviewThread
+type unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayload struct {
+	// The thread to resolve.
+	Thread *unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayloadThreadPullRequestReviewThread `json:"thread"`
+}
+
+// GetThread returns unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayload.Thread, and is useful for accessing the field via an interface.
+func (v *unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayload) GetThread() *unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayloadThreadPullRequestReviewThread {
+	return v.Thread
+}
+
+// unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayloadThreadPullRequestReviewThread includes the requested fields of the GraphQL type PullRequestReviewThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayloadThreadPullRequestReviewThread struct {
+	Id string `json:"id"`
+	// Whether this thread has been resolved
+	IsResolved bool `json:"isResolved"`
+}
+
+// GetId returns unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayloadThreadPullRequestReviewThread.Id, and is useful for accessing the field via an interface.
+func (v *unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayloadThreadPullRequestReviewThread) GetId() string {
+	return v.Id
+}
+
+// GetIsResolved returns unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayloadThreadPullRequestReviewThread.IsResolved, and is useful for accessing the field via an interface.
+func (v *unresolveReviewThreadUnresolveReviewThreadUnresolveReviewThreadPayloadThreadPullRequestReviewThread) GetIsResolved() bool {
+	return v.IsResolved
+}
+
+func addPendingPullRequestReview(
+	ctx context.Context,
+	client graphql.Client,
+	pullRequestId string,
+) (*addPendingPullRequestReviewResponse, error) {
+	req := &graphql.Request{
+		OpName: "addPendingPullRequestReview",
+		Query: `
+mutation addPendingPullRequestReview ($pullRequestId: ID!) {
+	addPullRequestReview(input: {pullRequestId:$pullRequestId}) {
+		pullRequestReview {
+			id
+		}
+	}
+}
+`,
+		Variables: &__addPendingPullRequestReviewInput{
+			PullRequestId: pullRequestId,
+		},
+	}
+	var err error
+
+	var data addPendingPullRequestReviewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func addPullRequestReview(
+	ctx context.Context,
+	client graphql.Client,
+	pullRequestId string,
+	event PullRequestReviewEvent,
+	body string,
+) (*addPullRequestReviewResponse, error) {
+	req := &graphql.Request{
+		OpName: "addPullRequestReview",
+		Query: `
+mutation addPullRequestReview ($pullRequestId: ID!, $event: PullRequestReviewEvent!, $body: String!) {
+	addPullRequestReview(input: {pullRequestId:$pullRequestId,event:$event,body:$body}) {
+		pullRequestReview {
+			... PullRequestReviewFields
+		}
+	}
+}
+fragment PullRequestReviewFields on PullRequestReview {
+	state
+	author {
+		__typename
+		login
+	}
+	submittedAt
+	commit {
+		oid
+		committedDate
+	}
+}
+`,
+		Variables: &__addPullRequestReviewInput{
+			PullRequestId: pullRequestId,
+			Event:         event,
+			Body:          body,
+		},
+	}
+	var err error
+
+	var data addPullRequestReviewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func addPullRequestReviewThread(
+	ctx context.Context,
+	client graphql.Client,
+	pullRequestReviewId string,
+	path string,
+	line int,
+	side DiffSide,
+	body string,
+) (*addPullRequestReviewThreadResponse, error) {
+	req := &graphql.Request{
+		OpName: "addPullRequestReviewThread",
+		Query: `
+mutation addPullRequestReviewThread ($pullRequestReviewId: ID!, $path: String!, $line: Int!, $side: DiffSide!, $body: String!) {
+	addPullRequestReviewThread(input: {pullRequestReviewId:$pullRequestReviewId,path:$path,line:$line,side:$side,body:$body}) {
+		thread {
+			id
+		}
+	}
+}
+`,
+		Variables: &__addPullRequestReviewThreadInput{
+			PullRequestReviewId: pullRequestReviewId,
+			Path:                path,
+			Line:                line,
+			Side:                side,
+			Body:                body,
+		},
+	}
+	var err error
+
+	var data addPullRequestReviewThreadResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deletePullRequestReview(
+	ctx context.Context,
+	client graphql.Client,
+	pullRequestReviewId string,
+) (*deletePullRequestReviewResponse, error) {
+	req := &graphql.Request{
+		OpName: "deletePullRequestReview",
+		Query: `
+mutation deletePullRequestReview ($pullRequestReviewId: ID!) {
+	deletePullRequestReview(input: {pullRequestReviewId:$pullRequestReviewId}) {
+		pullRequestReview {
+			id
+		}
+	}
+}
+`,
+		Variables: &__deletePullRequestReviewInput{
+			PullRequestReviewId: pullRequestReviewId,
+		},
+	}
+	var err error
+
+	var data deletePullRequestReviewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPendingPullRequestReview(
+	ctx context.Context,
+	client graphql.Client,
+	pullRequestId string,
+) (*getPendingPullRequestReviewResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPendingPullRequestReview",
+		Query: `
+query getPendingPullRequestReview ($pullRequestId: ID!) {
+	node(id: $pullRequestId) {
+		__typename
+		... on PullRequest {
+			reviews(states: [PENDING], first: 1) {
+				nodes {
+					id
+				}
+			}
+		}
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+`,
+		Variables: &__getPendingPullRequestReviewInput{
+			PullRequestId: pullRequestId,
+		},
+	}
+	var err error
+
+	var data getPendingPullRequestReviewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPullRequestChecks(
+	ctx context.Context,
+	client graphql.Client,
+	owner string,
+	name string,
+	number int,
+) (*getPullRequestChecksResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPullRequestChecks",
+		Query: `
+query getPullRequestChecks ($owner: String!, $name: String!, $number: Int!) {
+	repository(owner: $owner, name: $name) {
+		id
+		pullRequest(number: $number) {
+			commits(last: 1) {
+				nodes {
+					commit {
+						oid
+						checkSuites(first: 50) {
+							nodes {
+								id
+								app {
+									name
+								}
+								checkRuns(first: 100) {
+									nodes {
+										... CheckRunFields
+									}
+								}
+							}
+						}
+						status {
+							contexts {
+								... StatusContextFields
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment CheckRunFields on CheckRun {
+	id
+	name
+	status
+	conclusion
+	title
+	summary
+	detailsUrl
+	annotations(first: 50) {
+		nodes {
+			... CheckAnnotationFields
+		}
+	}
+}
+fragment StatusContextFields on StatusContext {
+	context
+	state
+	description
+	targetUrl
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+fragment CheckAnnotationFields on CheckAnnotation {
+	annotationLevel
+	path
+	title
+	message
+	location {
+		start {
+			line
+		}
+	}
+}
+`,
+		Variables: &__getPullRequestChecksInput{
+			Owner:  owner,
+			Name:   name,
+			Number: number,
+		},
+	}
+	var err error
+
+	var data getPullRequestChecksResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPullRequestDetails(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getPullRequestDetailsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPullRequestDetails",
+		Query: `
+query getPullRequestDetails ($id: ID!) {
+	node(id: $id) {
+		__typename
+		... on PullRequest {
+			id
+			number
+			title
+			body
+			url
+			isDraft
+			author {
+				__typename
+				login
+			}
+			createdAt
+			repository {
+				nameWithOwner
+			}
+			baseRefName
+			headRefName
+			additions
+			deletions
+			changedFiles
+			labels(first: 50) {
+				nodes {
+					name
+					color
+				}
+			}
+			assignees(first: 50) {
+				nodes {
+					login
+				}
+			}
+			latestReviews(first: 100) {
+				nodes {
+					... PullRequestReviewFields
+				}
+			}
+			reviewRequests(first: 100) {
+				nodes {
+					... ReviewRequestFields
+				}
+			}
+		}
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment PullRequestReviewFields on PullRequestReview {
+	state
+	author {
+		__typename
+		login
+	}
+	submittedAt
+	commit {
+		oid
+		committedDate
+	}
+}
+fragment ReviewRequestFields on ReviewRequest {
+	requestedReviewer {
+		__typename
+		... on User {
+			login
+		}
+		... on Team {
+			combinedSlug
+		}
+	}
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+`,
+		Variables: &__getPullRequestDetailsInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getPullRequestDetailsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPullRequestLatestReviews(
+	ctx context.Context,
+	client graphql.Client,
+	owner string,
+	name string,
+	number int,
+	after string,
+) (*getPullRequestLatestReviewsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPullRequestLatestReviews",
+		Query: `
+query getPullRequestLatestReviews ($owner: String!, $name: String!, $number: Int!, $after: String) {
+	repository(owner: $owner, name: $name) {
+		pullRequest(number: $number) {
+			latestReviews(first: 50, after: $after) {
+				pageInfo {
+					... PageInfoFields
+				}
+				nodes {
+					... PullRequestReviewFields
+				}
+			}
+		}
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
+fragment PullRequestReviewFields on PullRequestReview {
+	state
+	author {
+		__typename
+		login
+	}
+	submittedAt
+	commit {
+		oid
+		committedDate
+	}
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+`,
+		Variables: &__getPullRequestLatestReviewsInput{
+			Owner:  owner,
+			Name:   name,
+			Number: number,
+			After:  after,
+		},
+	}
+	var err error
+
+	var data getPullRequestLatestReviewsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPullRequestReviewRequests(
+	ctx context.Context,
+	client graphql.Client,
+	owner string,
+	name string,
+	number int,
+	after string,
+) (*getPullRequestReviewRequestsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPullRequestReviewRequests",
+		Query: `
+query getPullRequestReviewRequests ($owner: String!, $name: String!, $number: Int!, $after: String) {
+	repository(owner: $owner, name: $name) {
+		pullRequest(number: $number) {
+			reviewRequests(first: 50, after: $after) {
+				pageInfo {
+					... PageInfoFields
+				}
+				nodes {
+					... ReviewRequestFields
+				}
+			}
+		}
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
+fragment ReviewRequestFields on ReviewRequest {
+	requestedReviewer {
+		__typename
+		... on User {
+			login
+		}
+		... on Team {
+			combinedSlug
+		}
+	}
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+`,
+		Variables: &__getPullRequestReviewRequestsInput{
+			Owner:  owner,
+			Name:   name,
+			Number: number,
+			After:  after,
+		},
+	}
+	var err error
+
+	var data getPullRequestReviewRequestsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPullRequestReviewThreads(
+	ctx context.Context,
+	client graphql.Client,
+	owner string,
+	name string,
+	number int,
+	after string,
+) (*getPullRequestReviewThreadsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPullRequestReviewThreads",
+		Query: `
+query getPullRequestReviewThreads ($owner: String!, $name: String!, $number: Int!, $after: String) {
+	repository(owner: $owner, name: $name) {
+		pullRequest(number: $number) {
+			reviewThreads(first: 50, after: $after) {
+				pageInfo {
+					... PageInfoFields
+				}
+				nodes {
+					... ReviewThreadFields
+				}
+			}
+		}
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
+fragment ReviewThreadFields on PullRequestReviewThread {
+	id
+	isResolved
+	isOutdated
+	path
+	line
+	originalLine
+	comments(first: 100) {
+		nodes {
+			... ReviewCommentFields
+		}
+	}
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+fragment ReviewCommentFields on PullRequestReviewComment {
+	id
+	author {
+		__typename
+		login
+	}
+	body
+	createdAt
+}
+`,
+		Variables: &__getPullRequestReviewThreadsInput{
+			Owner:  owner,
+			Name:   name,
+			Number: number,
+			After:  after,
+		},
+	}
+	var err error
+
+	var data getPullRequestReviewThreadsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getRepositoryInfo(
+	ctx context.Context,
+	client graphql.Client,
+	owner string,
+	name string,
+	after string,
+) (*getRepositoryInfoResponse, error) {
+	req := &graphql.Request{
+		OpName: "getRepositoryInfo",
+		Query: `
+query getRepositoryInfo ($owner: String!, $name: String!, $after: String) {
+	repository(owner: $owner, name: $name) {
+		pullRequests(first: 50, states: OPEN, after: $after) {
+			pageInfo {
+				... PageInfoFields
+			}
+			nodes {
+				... PullRequestFields
+			}
+		}
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
+fragment PullRequestFields on PullRequest {
+	url
+	id
+	number
+	isDraft
+	reviewDecision
+	repository {
+		url
+		nameWithOwner
+	}
+	author {
+		__typename
+		login
+	}
+	createdAt
+	updatedAt
+	additions
+	deletions
+	headRefOid
+	labels(first: 20) {
+		nodes {
+			name
+		}
+	}
+	commits(last: 1) {
+		nodes {
+			commit {
+				oid
+				committedDate
+				statusCheckRollup {
+					state
+				}
+			}
+		}
+	}
+	latestReviews(first: 20) {
+		pageInfo {
+			... PageInfoFields
+		}
+		nodes {
+			... PullRequestReviewFields
+		}
+	}
+	title
+	reviewRequests(first: 20) {
+		pageInfo {
+			... PageInfoFields
+		}
+		nodes {
+			... ReviewRequestFields
+		}
+	}
+	reviewThreads(first: 100) {
+		nodes {
+			id
+			isResolved
+		}
+	}
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+fragment PullRequestReviewFields on PullRequestReview {
+	state
+	author {
+		__typename
+		login
+	}
+	submittedAt
+	commit {
+		oid
+		committedDate
+	}
+}
+fragment ReviewRequestFields on ReviewRequest {
+	requestedReviewer {
+		__typename
+		... on User {
+			login
+		}
+		... on Team {
+			combinedSlug
+		}
+	}
+}
+`,
+		Variables: &__getRepositoryInfoInput{
+			Owner: owner,
+			Name:  name,
+			After: after,
+		},
+	}
+	var err error
+
+	var data getRepositoryInfoResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getViewer(
 	ctx context.Context,
 	client graphql.Client,
-	owner string,
-	name string,
-) (*getRepositoryInfoResponse, error) {
+) (*getViewerResponse, error) {
 	req := &graphql.Request{
-		OpName: "getRepositoryInfo",
+		OpName: "getViewer",
 		Query: `
-query getRepositoryInfo ($owner: String!, $name: String!) {
-	repository(owner: $owner, name: $name) {
-		pullRequests(first: 20, states: OPEN) {
+query getViewer {
+	viewer {
+		login
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+`,
+	}
+	var err error
+
+	var data getViewerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getViewerTeams(
+	ctx context.Context,
+	client graphql.Client,
+	login string,
+	after string,
+) (*getViewerTeamsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getViewerTeams",
+		Query: `
+query getViewerTeams ($login: String!, $after: String) {
+	viewer {
+		organizations(first: 50, after: $after) {
+			pageInfo {
+				... PageInfoFields
+			}
 			nodes {
-				url
-				id
-				isDraft
-				author {
-					__typename
-					login
-				}
-				createdAt
-				latestReviews(first: 20) {
+				teams(first: 100, userLogins: [$login]) {
 					nodes {
-						state
-						author {
-							__typename
-							login
-						}
+						combinedSlug
 					}
 				}
-				title
-				reviewRequests(first: 20) {
-					nodes {
-						requestedReviewer {
-							__typename
-							... on User {
-								login
-							}
-						}
-					}
+			}
+		}
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+`,
+		Variables: &__getViewerTeamsInput{
+			Login: login,
+			After: after,
+		},
+	}
+	var err error
+
+	var data getViewerTeamsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func replyToReviewComment(
+	ctx context.Context,
+	client graphql.Client,
+	pullRequestId string,
+	inReplyTo string,
+	body string,
+) (*replyToReviewCommentResponse, error) {
+	req := &graphql.Request{
+		OpName: "replyToReviewComment",
+		Query: `
+mutation replyToReviewComment ($pullRequestId: ID!, $inReplyTo: ID!, $body: String!) {
+	addPullRequestReviewComment(input: {pullRequestId:$pullRequestId,inReplyTo:$inReplyTo,body:$body}) {
+		comment {
+			... ReviewCommentFields
+		}
+	}
+}
+fragment ReviewCommentFields on PullRequestReviewComment {
+	id
+	author {
+		__typename
+		login
+	}
+	body
+	createdAt
+}
+`,
+		Variables: &__replyToReviewCommentInput{
+			PullRequestId: pullRequestId,
+			InReplyTo:     inReplyTo,
+			Body:          body,
+		},
+	}
+	var err error
+
+	var data replyToReviewCommentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func rerequestCheckSuite(
+	ctx context.Context,
+	client graphql.Client,
+	repositoryId string,
+	checkSuiteId string,
+) (*rerequestCheckSuiteResponse, error) {
+	req := &graphql.Request{
+		OpName: "rerequestCheckSuite",
+		Query: `
+mutation rerequestCheckSuite ($repositoryId: ID!, $checkSuiteId: ID!) {
+	rerequestCheckSuite(input: {repositoryId:$repositoryId,checkSuiteId:$checkSuiteId}) {
+		checkSuite {
+			id
+		}
+	}
+}
+`,
+		Variables: &__rerequestCheckSuiteInput{
+			RepositoryId: repositoryId,
+			CheckSuiteId: checkSuiteId,
+		},
+	}
+	var err error
+
+	var data rerequestCheckSuiteResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func resolveReviewThread(
+	ctx context.Context,
+	client graphql.Client,
+	threadId string,
+) (*resolveReviewThreadResponse, error) {
+	req := &graphql.Request{
+		OpName: "resolveReviewThread",
+		Query: `
+mutation resolveReviewThread ($threadId: ID!) {
+	resolveReviewThread(input: {threadId:$threadId}) {
+		thread {
+			id
+			isResolved
+		}
+	}
+}
+`,
+		Variables: &__resolveReviewThreadInput{
+			ThreadId: threadId,
+		},
+	}
+	var err error
+
+	var data resolveReviewThreadResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func searchPullRequests(
+	ctx context.Context,
+	client graphql.Client,
+	query string,
+	after string,
+) (*searchPullRequestsResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchPullRequests",
+		Query: `
+query searchPullRequests ($query: String!, $after: String) {
+	search(type: ISSUE, query: $query, first: 50, after: $after) {
+		pageInfo {
+			... PageInfoFields
+		}
+		nodes {
+			__typename
+			... on PullRequest {
+				... PullRequestFields
+			}
+		}
+	}
+	rateLimit {
+		... RateLimitFields
+	}
+}
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
+fragment PullRequestFields on PullRequest {
+	url
+	id
+	number
+	isDraft
+	reviewDecision
+	repository {
+		url
+		nameWithOwner
+	}
+	author {
+		__typename
+		login
+	}
+	createdAt
+	updatedAt
+	additions
+	deletions
+	headRefOid
+	labels(first: 20) {
+		nodes {
+			name
+		}
+	}
+	commits(last: 1) {
+		nodes {
+			commit {
+				oid
+				committedDate
+				statusCheckRollup {
+					state
 				}
 			}
 		}
 	}
+	latestReviews(first: 20) {
+		pageInfo {
+			... PageInfoFields
+		}
+		nodes {
+			... PullRequestReviewFields
+		}
+	}
+	title
+	reviewRequests(first: 20) {
+		pageInfo {
+			... PageInfoFields
+		}
+		nodes {
+			... ReviewRequestFields
+		}
+	}
+	reviewThreads(first: 100) {
+		nodes {
+			id
+			isResolved
+		}
+	}
+}
+fragment RateLimitFields on RateLimit {
+	limit
+	remaining
+	resetAt
+	cost
+}
+fragment PullRequestReviewFields on PullRequestReview {
+	state
+	author {
+		__typename
+		login
+	}
+	submittedAt
+	commit {
+		oid
+		committedDate
+	}
+}
+fragment ReviewRequestFields on ReviewRequest {
+	requestedReviewer {
+		__typename
+		... on User {
+			login
+		}
+		... on Team {
+			combinedSlug
+		}
+	}
 }
 `,
-		Variables: &__getRepositoryInfoInput{
-			Owner: owner,
-			Name:  name,
+		Variables: &__searchPullRequestsInput{
+			Query: query,
+			After: after,
 		},
 	}
 	var err error
 
-	var data getRepositoryInfoResponse
+	var data searchPullRequestsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func submitPullRequestReview(
+	ctx context.Context,
+	client graphql.Client,
+	pullRequestReviewId string,
+	event PullRequestReviewEvent,
+	body string,
+) (*submitPullRequestReviewResponse, error) {
+	req := &graphql.Request{
+		OpName: "submitPullRequestReview",
+		Query: `
+mutation submitPullRequestReview ($pullRequestReviewId: ID!, $event: PullRequestReviewEvent!, $body: String!) {
+	submitPullRequestReview(input: {pullRequestReviewId:$pullRequestReviewId,event:$event,body:$body}) {
+		pullRequestReview {
+			... PullRequestReviewFields
+		}
+	}
+}
+fragment PullRequestReviewFields on PullRequestReview {
+	state
+	author {
+		__typename
+		login
+	}
+	submittedAt
+	commit {
+		oid
+		committedDate
+	}
+}
+`,
+		Variables: &__submitPullRequestReviewInput{
+			PullRequestReviewId: pullRequestReviewId,
+			Event:               event,
+			Body:                body,
+		},
+	}
+	var err error
+
+	var data submitPullRequestReviewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func unresolveReviewThread(
+	ctx context.Context,
+	client graphql.Client,
+	threadId string,
+) (*unresolveReviewThreadResponse, error) {
+	req := &graphql.Request{
+		OpName: "unresolveReviewThread",
+		Query: `
+mutation unresolveReviewThread ($threadId: ID!) {
+	unresolveReviewThread(input: {threadId:$threadId}) {
+		thread {
+			id
+			isResolved
+		}
+	}
+}
+`,
+		Variables: &__unresolveReviewThreadInput{
+			ThreadId: threadId,
+		},
+	}
+	var err error
+
+	var data unresolveReviewThreadResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1,9 +1,18 @@
 package main
 
 import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
 	"net/http"
+	"strings"
 )
 
 type AuthedTransport struct {
@@ -16,36 +25,483 @@ func (r *AuthedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return r.roundTripper.RoundTrip(req)
 }
 
-func NewGithubApi(token string) *GithubApi {
+// newClients creates clients authenticated with the given token. Both of them retry failed requests and respect rate
+// limits tracked by the rate limiter, and the GraphQL client also records the budget returned with each query.
+func (r *GithubApi) newClients(token string) (*graphql.Client, *http.Client) {
 	httpClient := http.Client{
 		Transport: &AuthedTransport{
-			token:        token,
-			roundTripper: http.DefaultTransport,
+			token: token,
+			roundTripper: &RetryTransport{
+				timeout:    r.settings.GetRequestTimeout(),
+				maxRetries: r.settings.GetMaxRetries(),
+				roundTripper: &RateLimitTransport{
+					rateLimiter:  r.RateLimiter,
+					roundTripper: http.DefaultTransport,
+				},
+				Logger: r.Logger,
+			},
 		},
 	}
 
-	graphqlClient := graphql.NewClient("https://api.github.com/graphql", &httpClient)
+	var graphqlClient graphql.Client = &RateLimitRecordingClient{
+		client:      &RetryableQueriesClient{client: graphql.NewClient("https://api.github.com/graphql", &httpClient)},
+		rateLimiter: r.RateLimiter,
+	}
+
+	return &graphqlClient, &httpClient
+}
+
+func NewGithubApi(token string, settings *Settings, logger *Logger) *GithubApi {
+	ctx, cancel := context.WithCancel(context.Background())
 
-	return &GithubApi{
-		client: &graphqlClient,
+	githubApi := &GithubApi{
+		ctx:         ctx,
+		cancel:      cancel,
+		settings:    settings,
+		RateLimiter: NewRateLimiter(),
+		Logger:      logger,
 	}
+	githubApi.client, githubApi.httpClient = githubApi.newClients(token)
+
+	return githubApi
 }
 
 type GithubApi struct {
-	client *graphql.Client
+	client     *graphql.Client
+	httpClient *http.Client
+	// ctx is the parent of contexts of all requests, so that they can be canceled at once when the app quits.
+	ctx         context.Context
+	cancel      context.CancelFunc
+	settings    *Settings
+	RateLimiter *RateLimiter
 	*Logger
 }
 
+// Context returns a context for requests which is canceled when the app quits.
+func (r *GithubApi) Context() context.Context {
+	return r.ctx
+}
+
+// CancelRequests cancels all requests in flight and makes any further requests fail immediately.
+func (r *GithubApi) CancelRequests() {
+	r.cancel()
+}
+
 func (r *GithubApi) UpdateClient(token string) {
 	r.Logger.Info(fmt.Sprintf("creating a new graphql client with token %v", token))
 
-	httpClient := http.Client{
-		Transport: &AuthedTransport{
-			token:        token,
-			roundTripper: http.DefaultTransport,
-		},
+	// Budget is tracked per token, so whatever was known about the previous one does not apply anymore.
+	r.RateLimiter.Reset()
+	r.client, r.httpClient = r.newClients(token)
+}
+
+// REPOSITORIES_PER_QUERY limits how many repositories are batched into a single GraphQL request.
+const REPOSITORIES_PER_QUERY = 10
+
+//go:embed genqlient.graphql
+var operationsDocument string
+
+// ParseRepositoryUrl extracts owner and name from a repository url such as https://github.com/owner/name.
+func ParseRepositoryUrl(repositoryUrl string) (string, string) {
+	urlParts := strings.Split(strings.TrimSuffix(repositoryUrl, "/"), "/")
+	if len(urlParts) < 2 {
+		return "", repositoryUrl
+	}
+
+	return urlParts[len(urlParts)-2], urlParts[len(urlParts)-1]
+}
+
+// ChunkRepositoryUrls splits repository urls into groups that are small enough to be fetched with a single request.
+func ChunkRepositoryUrls(repositoryUrls []string) [][]string {
+	var chunks [][]string
+	for start := 0; start < len(repositoryUrls); start += REPOSITORIES_PER_QUERY {
+		end := start + REPOSITORIES_PER_QUERY
+		if end > len(repositoryUrls) {
+			end = len(repositoryUrls)
+		}
+
+		chunks = append(chunks, repositoryUrls[start:end])
+	}
+
+	return chunks
+}
+
+// fragmentDefinitions returns definitions of the given fragments, and of all fragments they depend on, taken from
+// genqlient.graphql. GitHub rejects documents with unused fragments, so only the required ones are included. Like
+// genqlient does for its own queries, __typename is requested for every object, because generated types need it to
+// unmarshal interfaces and unions.
+func fragmentDefinitions(names ...string) (string, error) {
+	document, err := parser.ParseQuery(&ast.Source{Name: "genqlient.graphql", Input: operationsDocument})
+	if err != nil {
+		return "", err
+	}
+
+	required := ast.FragmentDefinitionList{}
+	var visit func(selectionSet ast.SelectionSet)
+	require := func(name string) {
+		if required.ForName(name) != nil {
+			return
+		}
+
+		fragment := document.Fragments.ForName(name)
+		if fragment == nil {
+			return
+		}
+
+		required = append(required, fragment)
+		visit(fragment.SelectionSet)
+	}
+	visit = func(selectionSet ast.SelectionSet) {
+		for _, selection := range selectionSet {
+			switch selection := selection.(type) {
+			case *ast.Field:
+				if len(selection.SelectionSet) > 0 {
+					selection.SelectionSet = append(ast.SelectionSet{&ast.Field{Name: "__typename"}}, selection.SelectionSet...)
+				}
+				visit(selection.SelectionSet)
+			case *ast.InlineFragment:
+				visit(selection.SelectionSet)
+			case *ast.FragmentSpread:
+				require(selection.Name)
+			}
+		}
+	}
+
+	for _, name := range names {
+		require(name)
 	}
 
-	graphqlClient := graphql.NewClient("https://api.github.com/graphql", &httpClient)
-	r.client = &graphqlClient
+	var builder strings.Builder
+	formatter.NewFormatter(&builder).FormatQueryDocument(&ast.QueryDocument{Fragments: required})
+
+	return builder.String(), nil
+}
+
+// GetRepositoriesPullRequests fetches the first page of open pull requests of every given repository with a single
+// request. Each repository is queried under its own field alias and results are split back out by repository url.
+// Repositories that could not be fetched are returned in the second map together with the reason.
+func (r *GithubApi) GetRepositoriesPullRequests(ctx context.Context, repositoryUrls []string) (map[string]*getRepositoryInfoRepository, map[string]error) {
+	failures := map[string]error{}
+
+	fragments, err := fragmentDefinitions("PageInfoFields", "PullRequestFields", "RateLimitFields")
+	if err != nil {
+		for _, repositoryUrl := range repositoryUrls {
+			failures[repositoryUrl] = err
+		}
+		return nil, failures
+	}
+
+	var variableDefinitions []string
+	var fields strings.Builder
+	variables := map[string]any{}
+	aliases := map[string]string{}
+	for i, repositoryUrl := range repositoryUrls {
+		owner, name := ParseRepositoryUrl(repositoryUrl)
+		alias := fmt.Sprintf("repository%v", i)
+		aliases[repositoryUrl] = alias
+
+		variableDefinitions = append(variableDefinitions, fmt.Sprintf("$owner%v: String!", i), fmt.Sprintf("$name%v: String!", i))
+		variables[fmt.Sprintf("owner%v", i)] = owner
+		variables[fmt.Sprintf("name%v", i)] = name
+
+		fields.WriteString(fmt.Sprintf(`
+  %v: repository(owner: $owner%v, name: $name%v) {
+    pullRequests(first: 50, states: OPEN) {
+      pageInfo {
+        ...PageInfoFields
+      }
+      nodes {
+        ...PullRequestFields
+      }
+    }
+  }`, alias, i, i))
+	}
+
+	fields.WriteString(`
+  rateLimit {
+    ...RateLimitFields
+  }`)
+
+	req := &graphql.Request{
+		OpName:    "getRepositoriesInfo",
+		Query:     fmt.Sprintf("query getRepositoriesInfo(%v) {%v\n}\n%v", strings.Join(variableDefinitions, ", "), fields.String(), fragments),
+		Variables: variables,
+	}
+
+	// Fields of the response have different types, so each of them is decoded separately.
+	data := map[string]json.RawMessage{}
+	err = (*r.client).MakeRequest(ctx, req, &graphql.Response{Data: &data})
+
+	if data["rateLimit"] != nil {
+		var rateLimit *RateLimitFields
+		if json.Unmarshal(data["rateLimit"], &rateLimit) == nil {
+			r.RateLimiter.Update(rateLimit)
+		}
+	}
+
+	// GitHub responds with partial data when only some of the repositories can not be resolved, so errors are
+	// attributed to repositories based on the path they were reported for.
+	var errorList gqlerror.List
+	errors.As(err, &errorList)
+
+	repositories := map[string]*getRepositoryInfoRepository{}
+	for repositoryUrl, alias := range aliases {
+		var repository *getRepositoryInfoRepository
+		if data[alias] != nil {
+			if decodeErr := json.Unmarshal(data[alias], &repository); decodeErr != nil {
+				failures[repositoryUrl] = decodeErr
+				continue
+			}
+		}
+
+		if repository != nil {
+			repositories[repositoryUrl] = repository
+			continue
+		}
+
+		failures[repositoryUrl] = err
+		for _, graphqlError := range errorList {
+			if len(graphqlError.Path) > 0 && graphqlError.Path[0] == ast.PathName(alias) {
+				failures[repositoryUrl] = graphqlError
+			}
+		}
+
+		if failures[repositoryUrl] == nil {
+			failures[repositoryUrl] = fmt.Errorf("repository %v is missing in response", repositoryUrl)
+		}
+	}
+
+	return repositories, failures
+}
+
+// PullRequestFile is a file changed in a pull request, as returned by the REST API.
+type PullRequestFile struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename"`
+	Status           string `json:"status"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Patch            string `json:"patch"`
+}
+
+// GetPullRequestFiles fetches files changed in a pull request together with their patches. Patches are not exposed by
+// the GraphQL API, so the REST API is used instead.
+func (r *GithubApi) GetPullRequestFiles(ctx context.Context, owner string, name string, number int, maxPages int) ([]*PullRequestFile, error) {
+	var files []*PullRequestFile
+
+	for page := 1; page <= maxPages; page++ {
+		url := fmt.Sprintf("https://api.github.com/repos/%v/%v/pulls/%v/files?per_page=100&page=%v", owner, name, number, page)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+
+		res, err := r.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageFiles []*PullRequestFile
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return nil, fmt.Errorf("returned error %v while fetching files of %v/%v#%v", res.Status, owner, name, number)
+		}
+
+		err = json.NewDecoder(res.Body).Decode(&pageFiles)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, pageFiles...)
+
+		if len(pageFiles) < 100 {
+			return files, nil
+		}
+	}
+
+	r.Logger.Info(fmt.Sprintf("reached page limit of %v while fetching files of %v/%v#%v", maxPages, owner, name, number))
+
+	return files, nil
+}
+
+// AddPullRequestReview submits a review with the given verdict and returns it as it was saved by GitHub.
+func (r *GithubApi) AddPullRequestReview(ctx context.Context, pullRequestId string, event PullRequestReviewEvent, body string) (*PullRequestReviewFields, error) {
+	// A review can not be added while I have a pending one, so the pending one is submitted instead.
+	pendingReviewId, err := r.findPendingReview(ctx, pullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if pendingReviewId != "" {
+		return r.SubmitPullRequestReviewWithComments(ctx, pullRequestId, event, body, nil)
+	}
+
+	response, err := addPullRequestReview(ctx, *r.client, pullRequestId, event, body)
+	if err != nil {
+		return nil, err
+	}
+
+	review := response.GetAddPullRequestReview().GetPullRequestReview()
+	if review == nil {
+		return nil, fmt.Errorf("review of pull request %v is missing in response", pullRequestId)
+	}
+
+	return review, nil
+}
+
+// findPendingReview returns the id of my pending review of the pull request, e.g. one started in the browser, or an
+// empty string if there is none. Pending reviews are only visible to their authors, so any that is found is mine.
+func (r *GithubApi) findPendingReview(ctx context.Context, pullRequestId string) (string, error) {
+	response, err := getPendingPullRequestReview(ctx, *r.client, pullRequestId)
+	if err != nil {
+		return "", err
+	}
+
+	pullRequest, ok := response.GetNode().(*getPendingPullRequestReviewNodePullRequest)
+	if !ok || pullRequest.GetReviews() == nil || len(pullRequest.GetReviews().GetNodes()) == 0 {
+		return "", nil
+	}
+
+	return pullRequest.GetReviews().GetNodes()[0].GetId(), nil
+}
+
+// SubmitPullRequestReviewWithComments submits a review together with line comments. A pending review is created first,
+// comments are added to it one by one and then it is submitted with the given verdict. When any step fails, the
+// pending review is deleted, so that it does not block submitting the review again.
+//
+// GitHub allows a single pending review per user, so when I already have one, e.g. started in the browser, comments are
+// added to it instead. It is submitted together with comments it already had, and it is never deleted, so that they are
+// not lost.
+func (r *GithubApi) SubmitPullRequestReviewWithComments(ctx context.Context, pullRequestId string, event PullRequestReviewEvent, body string, comments []*DraftComment) (*PullRequestReviewFields, error) {
+	pullRequestReviewId, err := r.findPendingReview(ctx, pullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	isExistingReview := pullRequestReviewId != ""
+	if !isExistingReview {
+		pendingReviewResponse, err := addPendingPullRequestReview(ctx, *r.client, pullRequestId)
+		if err != nil {
+			return nil, err
+		}
+
+		pullRequestReviewId = pendingReviewResponse.GetAddPullRequestReview().GetPullRequestReview().GetId()
+	}
+
+	review, err := func() (*PullRequestReviewFields, error) {
+		for _, comment := range comments {
+			_, err := addPullRequestReviewThread(ctx, *r.client, pullRequestReviewId, comment.Path, comment.Line, comment.Side, comment.Body)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		response, err := submitPullRequestReview(ctx, *r.client, pullRequestReviewId, event, body)
+		if err != nil {
+			return nil, err
+		}
+
+		review := response.GetSubmitPullRequestReview().GetPullRequestReview()
+		if review == nil {
+			return nil, fmt.Errorf("review of pull request %v is missing in response", pullRequestId)
+		}
+
+		return review, nil
+	}()
+
+	if err != nil {
+		if isExistingReview {
+			return nil, err
+		}
+
+		_, deleteErr := deletePullRequestReview(ctx, *r.client, pullRequestReviewId)
+		if deleteErr != nil {
+			r.Logger.Info(fmt.Sprintf("could not delete pending review %v", pullRequestReviewId))
+			r.Logger.Error(deleteErr)
+		}
+
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// GetPullRequestReviewThreads fetches all review threads of a pull request, following pages until there are no more
+// pages or the page limit is reached.
+func (r *GithubApi) GetPullRequestReviewThreads(ctx context.Context, owner string, name string, number int, maxPages int) ([]*ReviewThreadFields, error) {
+	var threads []*ReviewThreadFields
+	after := ""
+
+	for page := 1; page <= maxPages; page++ {
+		response, err := getPullRequestReviewThreads(ctx, *r.client, owner, name, number, after)
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.GetRepository().GetPullRequest().GetReviewThreads()
+		threads = append(threads, connection.GetNodes()...)
+
+		if !connection.GetPageInfo().GetHasNextPage() {
+			return threads, nil
+		}
+		after = connection.GetPageInfo().GetEndCursor()
+	}
+
+	r.Logger.Info(fmt.Sprintf("reached page limit of %v while fetching review threads of %v/%v#%v", maxPages, owner, name, number))
+
+	return threads, nil
+}
+
+// SetReviewThreadResolved resolves or unresolves a review thread and returns its state as saved by GitHub.
+func (r *GithubApi) SetReviewThreadResolved(ctx context.Context, threadId string, resolved bool) (bool, error) {
+	if resolved {
+		response, err := resolveReviewThread(ctx, *r.client, threadId)
+		if err != nil {
+			return false, err
+		}
+
+		return response.GetResolveReviewThread().GetThread().GetIsResolved(), nil
+	}
+
+	response, err := unresolveReviewThread(ctx, *r.client, threadId)
+	if err != nil {
+		return false, err
+	}
+
+	return response.GetUnresolveReviewThread().GetThread().GetIsResolved(), nil
+}
+
+// ReplyToReviewComment adds a comment to the thread the given comment belongs to.
+func (r *GithubApi) ReplyToReviewComment(ctx context.Context, pullRequestId string, commentId string, body string) (*ReviewCommentFields, error) {
+	response, err := replyToReviewComment(ctx, *r.client, pullRequestId, commentId, body)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := response.GetAddPullRequestReviewComment().GetComment()
+	if comment == nil {
+		return nil, fmt.Errorf("reply to comment %v is missing in response", commentId)
+	}
+
+	return comment, nil
+}
+
+// GetPullRequestChecks fetches check suites, check runs and status contexts of the latest commit of a pull request.
+func (r *GithubApi) GetPullRequestChecks(ctx context.Context, owner string, name string, number int) (*getPullRequestChecksRepository, error) {
+	response, err := getPullRequestChecks(ctx, *r.client, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetRepository(), nil
+}
+
+// RerequestCheckSuite runs all checks of the check suite again.
+func (r *GithubApi) RerequestCheckSuite(ctx context.Context, repositoryId string, checkSuiteId string) error {
+	_, err := rerequestCheckSuite(ctx, *r.client, repositoryId, checkSuiteId)
+
+	return err
 }
@@ -1,5 +1,10 @@
 package main
 
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+)
+
 type Help struct {
 	Shortcut    string
 	Description string
@@ -83,3 +88,257 @@ var helpUpdateUsername = Help{
 	Description: "Update username",
 	Display:     "Ctrl + B",
 }
+
+var helpRefreshPullRequests = Help{
+	Shortcut:    "r",
+	Description: "Refresh pull requests",
+	Display:     "R",
+}
+
+var helpUpdateRefreshInterval = Help{
+	Shortcut:    "ctrl+e",
+	Description: "Update refresh interval",
+	Display:     "Ctrl + E",
+}
+
+var helpToggleDiscovery = Help{
+	Shortcut:    "ctrl+d",
+	Description: "Toggle discovery of review requests in unwatched repositories",
+	Display:     "Ctrl + D",
+}
+
+var helpShowPullRequestDetails = Help{
+	Shortcut:    "d",
+	Description: "Show pull request details",
+	Display:     "D",
+}
+
+var helpBack = Help{
+	Shortcut:    "esc",
+	Description: "Go back to pull requests",
+	Display:     "Escape",
+}
+
+var helpShowDiff = Help{
+	Shortcut:    "v",
+	Description: "Show changes of pull request",
+	Display:     "V",
+}
+
+var helpNextFile = Help{
+	Shortcut:    "]",
+	Description: "Next file",
+	Display:     "]",
+}
+
+var helpPreviousFile = Help{
+	Shortcut:    "[",
+	Description: "Previous file",
+	Display:     "[",
+}
+
+var helpNextHunk = Help{
+	Shortcut:    "}",
+	Description: "Next hunk",
+	Display:     "}",
+}
+
+var helpPreviousHunk = Help{
+	Shortcut:    "{",
+	Description: "Previous hunk",
+	Display:     "{",
+}
+
+var helpToggleDiffLayout = Help{
+	Shortcut:    "s",
+	Description: "Toggle unified and split layout",
+	Display:     "S",
+}
+
+var helpReviewPullRequest = Help{
+	Shortcut:    "c",
+	Description: "Review selected pull request",
+	Display:     "C",
+}
+
+var helpSwitchReviewEvent = Help{
+	Shortcut:    "tab",
+	Description: "Switch review verdict",
+	Display:     "Tab",
+}
+
+var helpSubmitReview = Help{
+	Shortcut:    "ctrl+x",
+	Description: "Submit review",
+	Display:     "Ctrl + X",
+}
+
+var helpCommentLine = Help{
+	Shortcut:    "c",
+	Description: "Comment selected line",
+	Display:     "C",
+}
+
+var helpDeleteDraftComments = Help{
+	Shortcut:    "x",
+	Description: "Delete pending comments of selected line",
+	Display:     "X",
+}
+
+var helpSaveDraftComment = Help{
+	Shortcut:    "ctrl+x",
+	Description: "Add comment to pending review",
+	Display:     "Ctrl + X",
+}
+
+var helpShowReviewThreads = Help{
+	Shortcut:    "t",
+	Description: "Show review threads",
+	Display:     "T",
+}
+
+var helpToggleThreadResolved = Help{
+	Shortcut:    "r",
+	Description: "Resolve or unresolve selected thread",
+	Display:     "R",
+}
+
+var helpReplyToThread = Help{
+	Shortcut:    "c",
+	Description: "Reply to selected thread",
+	Display:     "C",
+}
+
+var helpSendReply = Help{
+	Shortcut:    "ctrl+x",
+	Description: "Send reply",
+	Display:     "Ctrl + X",
+}
+
+var helpSwitchFailingChecks = Help{
+	Shortcut:    "ctrl+f",
+	Description: "Switch how pull requests with failing checks are displayed",
+	Display:     "Ctrl + F",
+}
+
+var helpShowChecks = Help{
+	Shortcut:    "i",
+	Description: "Show checks of pull request",
+	Display:     "I",
+}
+
+var helpRerequestCheckSuite = Help{
+	Shortcut:    "r",
+	Description: "Re-run failed check suite of selected check",
+	Display:     "R",
+}
+
+var helpOpenCheck = Help{
+	Shortcut:    "enter",
+	Description: "Open selected check",
+	Display:     "Enter",
+}
+
+var helpSwitchPullRequestsTab = Help{
+	Shortcut:    "tab",
+	Description: "Switch between review requests and my pull requests",
+	Display:     "Tab",
+}
+
+var helpSearchPullRequests = Help{
+	Shortcut:    "/",
+	Description: "Search pull requests",
+	Display:     "/",
+}
+
+var helpApplyFilter = Help{
+	Shortcut:    "enter",
+	Description: "Apply filter",
+	Display:     "Enter",
+}
+
+var helpClearFilter = Help{
+	Shortcut:    "esc",
+	Description: "Clear filter",
+	Display:     "Escape",
+}
+
+var helpSwitchSortMode = Help{
+	Shortcut:    "o",
+	Description: "Switch sort order",
+	Display:     "O",
+}
+
+var helpToggleGroupByRepository = Help{
+	Shortcut:    "ctrl+g",
+	Description: "Group pull requests by repository",
+	Display:     "Ctrl + G",
+}
+
+var helpToggleRepositoryGroup = Help{
+	Shortcut:    " ",
+	Description: "Collapse or expand repository",
+	Display:     "Space",
+}
+
+var helpNextRepositoryGroup = Help{
+	Shortcut:    "]",
+	Description: "Next repository",
+	Display:     "]",
+}
+
+var helpPreviousRepositoryGroup = Help{
+	Shortcut:    "[",
+	Description: "Previous repository",
+	Display:     "[",
+}
+
+var helpSelectColumns = Help{
+	Shortcut:    "ctrl+l",
+	Description: "Choose columns of pull requests table",
+	Display:     "Ctrl + L",
+}
+
+var helpToggleColumn = Help{
+	Shortcut:    " ",
+	Description: "Show or hide selected column",
+	Display:     "Space",
+}
+
+var helpPageUp = Help{
+	Shortcut:    "pgup",
+	Description: "Page up",
+	Display:     "PgUp",
+}
+
+var helpPageDown = Help{
+	Shortcut:    "pgdown",
+	Description: "Page down",
+	Display:     "PgDown",
+}
+
+var helpGoToTop = Help{
+	Shortcut:    "home",
+	Description: "Go to top",
+	Display:     "Home / G G",
+}
+
+var helpGoToBottom = Help{
+	Shortcut:    "end",
+	Description: "Go to bottom",
+	Display:     "End / Shift + G",
+}
+
+// RenderHelp renders shortcuts next to each other, wrapped so that they fit into the given width.
+func RenderHelp(helps []Help, width int) (string, error) {
+	helpString := ""
+	for _, help := range helps {
+		helpString += lipgloss.JoinHorizontal(lipgloss.Left, StyledHelpShortcut.Render(help.Display), " ", StyledHelpDescription.Render(help.Description), "   ")
+	}
+
+	wrapper := wordwrap.NewWriter(width)
+	wrapper.Breakpoints = []rune{' '}
+	_, err := wrapper.Write([]byte(helpString))
+
+	return wrapper.String(), err
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"github.com/charmbracelet/lipgloss"
+	"math"
+	"strings"
+)
+
+// ListViewport displays lines of a list in a viewport which follows the selected line, so that the list can be longer
+// than the window. The scroll position is updated with Layout while handling messages, View only renders it.
+type ListViewport struct {
+	offset int
+	height int
+	// lastKey is the previously pressed key, needed to recognize gg.
+	lastKey string
+}
+
+func NewListViewport() *ListViewport {
+	return &ListViewport{
+		height: 1,
+	}
+}
+
+// Layout remembers the size of the list and scrolls it just enough to show the selected line.
+func (r *ListViewport) Layout(lineCount int, selectedLine int, height int) {
+	r.height = int(math.Max(float64(height), 1))
+	r.offset = scrollOffset(r.offset, lineCount, selectedLine, r.height)
+}
+
+// scrollOffset returns the index of the first visible line, moved from the given one only as much as needed to show
+// the selected line. Content may have become shorter, e.g. after filtering, so the offset is clamped as well.
+func scrollOffset(offset int, lineCount int, selectedLine int, height int) int {
+	if selectedLine < offset {
+		offset = selectedLine
+	} else if selectedLine >= offset+height {
+		offset = selectedLine - height + 1
+	}
+
+	return int(math.Max(math.Min(float64(offset), float64(lineCount-height)), 0))
+}
+
+// Navigate moves the selection by pages or to either end of a list of the given length. It returns the new index of
+// the selected item, and false if the key is not related to scrolling.
+func (r *ListViewport) Navigate(key string, index int, length int) (int, bool) {
+	lastKey := r.lastKey
+	r.lastKey = key
+
+	if length == 0 {
+		return index, false
+	}
+
+	switch key {
+	case helpPageDown.Shortcut:
+		return int(math.Min(float64(index+r.height), float64(length-1))), true
+	case helpPageUp.Shortcut:
+		return int(math.Max(float64(index-r.height), 0)), true
+	case helpGoToTop.Shortcut:
+		return 0, true
+	case helpGoToBottom.Shortcut, "G":
+		return length - 1, true
+	case "g":
+		if lastKey == "g" {
+			r.lastKey = ""
+			return 0, true
+		}
+	}
+
+	return index, false
+}
+
+// View renders visible lines followed by the scroll indicator. Arguments are expected to be the ones that Layout was
+// called with, they are passed again only to keep the selected line visible should they differ.
+func (r *ListViewport) View(lines []string, selectedLine int, width int, height int) string {
+	height = int(math.Max(float64(height), 1))
+	offset := scrollOffset(r.offset, len(lines), selectedLine, height)
+	last := int(math.Min(float64(offset+height), float64(len(lines))))
+
+	visible := make([]string, height)
+	for i := range visible {
+		if offset+i < last {
+			visible[i] = fitWidth(lines[offset+i], width)
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, strings.Join(visible, "\n"), scrollIndicator(offset, height, len(lines)))
+}
+
+// scrollIndicator describes which lines are visible, or returns an empty string if all of them are.
+func scrollIndicator(offset int, height int, total int) string {
+	if total <= height {
+		return ""
+	}
+
+	last := int(math.Min(float64(offset+height), float64(total)))
+
+	return StyledHelpDescription.Render(fmt.Sprintf("lines %v-%v of %v", offset+1, last, total))
+}
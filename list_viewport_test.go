@@ -0,0 +1,72 @@
+package main
+
+import "testing"
+
+func TestListViewportNavigate(t *testing.T) {
+	tests := []struct {
+		name   string
+		keys   []string
+		index  int
+		length int
+		want   int
+		ok     bool
+	}{
+		{name: "page down", keys: []string{"pgdown"}, index: 2, length: 20, want: 7, ok: true},
+		{name: "page down stops at the last item", keys: []string{"pgdown"}, index: 17, length: 20, want: 19, ok: true},
+		{name: "page up", keys: []string{"pgup"}, index: 12, length: 20, want: 7, ok: true},
+		{name: "page up stops at the first item", keys: []string{"pgup"}, index: 3, length: 20, want: 0, ok: true},
+		{name: "home", keys: []string{"home"}, index: 12, length: 20, want: 0, ok: true},
+		{name: "end", keys: []string{"end"}, index: 3, length: 20, want: 19, ok: true},
+		{name: "G", keys: []string{"G"}, index: 3, length: 20, want: 19, ok: true},
+		{name: "gg", keys: []string{"g", "g"}, index: 12, length: 20, want: 0, ok: true},
+		{name: "single g", keys: []string{"g"}, index: 12, length: 20, want: 12, ok: false},
+		{name: "g after another key", keys: []string{"x", "g"}, index: 12, length: 20, want: 12, ok: false},
+		{name: "ggg waits for another g", keys: []string{"g", "g", "g"}, index: 12, length: 20, want: 0, ok: false},
+		{name: "two pages down", keys: []string{"pgdown", "pgdown"}, index: 2, length: 20, want: 12, ok: true},
+		{name: "page down and back up", keys: []string{"pgdown", "pgup"}, index: 2, length: 20, want: 2, ok: true},
+		{name: "pages down past the last item", keys: []string{"pgdown", "pgdown", "pgdown", "pgdown"}, index: 2, length: 20, want: 19, ok: true},
+		{name: "gg after paging", keys: []string{"pgdown", "g", "g"}, index: 2, length: 20, want: 0, ok: true},
+		{name: "unrelated key", keys: []string{"j"}, index: 12, length: 20, want: 12, ok: false},
+		{name: "empty list", keys: []string{"end"}, index: 0, length: 0, want: 0, ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			listViewport := NewListViewport()
+			listViewport.Layout(test.length, test.index, 5)
+
+			index, ok := test.index, false
+			for _, key := range test.keys {
+				index, ok = listViewport.Navigate(key, index, test.length)
+			}
+
+			if index != test.want || ok != test.ok {
+				t.Errorf("Navigate(%v) = %v, %v, want %v, %v", test.keys, index, ok, test.want, test.ok)
+			}
+		})
+	}
+}
+
+func TestScrollOffset(t *testing.T) {
+	tests := []struct {
+		name         string
+		offset       int
+		lineCount    int
+		selectedLine int
+		want         int
+	}{
+		{name: "selected line is visible", offset: 2, lineCount: 20, selectedLine: 4, want: 2},
+		{name: "selected line is above", offset: 5, lineCount: 20, selectedLine: 3, want: 3},
+		{name: "selected line is below", offset: 0, lineCount: 20, selectedLine: 9, want: 5},
+		{name: "list became shorter", offset: 15, lineCount: 8, selectedLine: 7, want: 3},
+		{name: "list fits", offset: 3, lineCount: 4, selectedLine: 3, want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := scrollOffset(test.offset, test.lineCount, test.selectedLine, 5); got != test.want {
+				t.Errorf("scrollOffset() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -1,24 +1,51 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/lipgloss"
 	"strconv"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 const SCREEN_SETTINGS = "settings"
 const SCREEN_PULL_REQUESTS = "pull_requests"
+const SCREEN_PULL_REQUEST_DETAILS = "pull_request_details"
+const SCREEN_DIFF = "diff"
+const SCREEN_REVIEW_THREADS = "review_threads"
+const SCREEN_CHECKS = "checks"
 
-func NewRouter(settingsScreen *SettingsScreen, pullRequestsScreen *PullRequestsScreen, globalState *Window, settings *Settings, logger *Logger) *Router {
+// openScreenMsg switches the router to another screen. Screens showing a single pull request receive it when opened.
+type openScreenMsg struct {
+	screen      string
+	pullRequest *PullRequest
+}
+
+func openScreen(screen string, pullRequest *PullRequest) tea.Cmd {
+	return func() tea.Msg {
+		return openScreenMsg{
+			screen:      screen,
+			pullRequest: pullRequest,
+		}
+	}
+}
+
+func NewRouter(settingsScreen *SettingsScreen, pullRequestsScreen *PullRequestsScreen, pullRequestDetailsScreen *PullRequestDetailsScreen, diffScreen *DiffScreen, reviewThreadsScreen *ReviewThreadsScreen, checksScreen *ChecksScreen, globalState *Window, settings *Settings, logger *Logger, githubApi *GithubApi) *Router {
 	return &Router{
-		currentScreen:      SCREEN_PULL_REQUESTS,
-		SettingsScreen:     settingsScreen,
-		PullRequestsScreen: pullRequestsScreen,
-		Window:             globalState,
-		Settings:           settings,
-		Logger:             logger,
+		currentScreen:            SCREEN_PULL_REQUESTS,
+		SettingsScreen:           settingsScreen,
+		PullRequestsScreen:       pullRequestsScreen,
+		PullRequestDetailsScreen: pullRequestDetailsScreen,
+		DiffScreen:               diffScreen,
+		ReviewThreadsScreen:      reviewThreadsScreen,
+		ChecksScreen:             checksScreen,
+		Window:                   globalState,
+		Settings:                 settings,
+		Logger:                   logger,
+		GithubApi:                githubApi,
 	}
 
 }
@@ -27,29 +54,153 @@ type Router struct {
 	currentScreen string
 	*SettingsScreen
 	*PullRequestsScreen
+	*PullRequestDetailsScreen
+	*DiffScreen
+	*ReviewThreadsScreen
+	*ChecksScreen
 	*Window
 	*Settings
 	*Logger
+	GithubApi *GithubApi
+	// toasts are shown in the status line, the most recent one on top of the others until it expires.
+	toasts      []*Toast
+	nextToastId int
+}
+
+// SCREENS lists all screens the router can switch to.
+var SCREENS = []string{SCREEN_SETTINGS, SCREEN_PULL_REQUESTS, SCREEN_PULL_REQUEST_DETAILS, SCREEN_DIFF, SCREEN_REVIEW_THREADS, SCREEN_CHECKS}
+
+func isScreen(screen string) bool {
+	for _, s := range SCREENS {
+		if s == screen {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (r *Router) Init() tea.Cmd {
-	r.SettingsScreen.Init()
-	r.PullRequestsScreen.Init()
-	return nil
+	return tea.Batch(r.SettingsScreen.Init(), r.PullRequestsScreen.Init(), r.PullRequestDetailsScreen.Init(), r.DiffScreen.Init(), r.ReviewThreadsScreen.Init(), r.ChecksScreen.Init())
 }
 
 func (r *Router) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	model, cmd := r.update(msg)
+
+	// Laying out a list renders all of its rows, so it is done only for the screen that is displayed, and not for
+	// spinner ticks, which only animate the spinner.
+	if _, ok := msg.(spinner.TickMsg); !ok {
+		switch r.currentScreen {
+		case SCREEN_SETTINGS:
+			r.SettingsScreen.layoutList()
+		case SCREEN_PULL_REQUESTS:
+			r.PullRequestsScreen.layoutList()
+		}
+	}
+
+	return model, cmd
+}
+
+func (r *Router) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
-	if r.currentScreen == SCREEN_SETTINGS {
-		_, cmd = r.SettingsScreen.Update(msg)
+	// Window size is updated before screens receive the message, so that they can lay themselves out using it.
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		r.Logger.Info(fmt.Sprintf("window width is set to %v", strconv.Itoa(msg.Width)))
+		r.Logger.Info(fmt.Sprintf("window height is set to %v", strconv.Itoa(msg.Height)))
+		r.Window.Height = msg.Height - STATUS_BAR_HEIGHT
+		r.Window.Width = msg.Width
+
+		StyledHeader.Width(msg.Width - lipgloss.RoundedBorder().GetLeftSize() - lipgloss.RoundedBorder().GetRightSize() - StyledMain.GetHorizontalPadding())
 	}
 
-	if r.currentScreen == SCREEN_PULL_REQUESTS {
-		_, cmd = r.PullRequestsScreen.Update(msg)
+	if _, ok := msg.(tea.KeyMsg); ok {
+		if r.currentScreen == SCREEN_SETTINGS {
+			_, cmd = r.SettingsScreen.Update(msg)
+		}
+
+		if r.currentScreen == SCREEN_PULL_REQUESTS {
+			_, cmd = r.PullRequestsScreen.Update(msg)
+		}
+
+		if r.currentScreen == SCREEN_PULL_REQUEST_DETAILS {
+			_, cmd = r.PullRequestDetailsScreen.Update(msg)
+		}
+
+		if r.currentScreen == SCREEN_DIFF {
+			_, cmd = r.DiffScreen.Update(msg)
+		}
+
+		if r.currentScreen == SCREEN_REVIEW_THREADS {
+			_, cmd = r.ReviewThreadsScreen.Update(msg)
+		}
+
+		if r.currentScreen == SCREEN_CHECKS {
+			_, cmd = r.ChecksScreen.Update(msg)
+		}
+	} else {
+		// Messages other than key presses (e.g. results of asynchronous requests) are delivered to every screen, so
+		// screens that are not currently displayed can still update their state in the background.
+		_, settingsCmd := r.SettingsScreen.Update(msg)
+		_, pullRequestsCmd := r.PullRequestsScreen.Update(msg)
+		_, pullRequestDetailsCmd := r.PullRequestDetailsScreen.Update(msg)
+		_, diffCmd := r.DiffScreen.Update(msg)
+		_, reviewThreadsCmd := r.ReviewThreadsScreen.Update(msg)
+		_, checksCmd := r.ChecksScreen.Update(msg)
+		cmd = tea.Batch(settingsCmd, pullRequestsCmd, pullRequestDetailsCmd, diffCmd, reviewThreadsCmd, checksCmd)
 	}
 
 	switch msg := msg.(type) {
+	case toastMsg:
+		{
+			if msg.level == TOAST_ERROR {
+				r.Logger.Error(errors.New(msg.message))
+			} else {
+				r.Logger.Info(fmt.Sprintf("%v: %v", msg.level, msg.message))
+			}
+
+			r.nextToastId++
+			id := r.nextToastId
+			r.toasts = append(r.toasts, &Toast{id: id, level: msg.level, message: msg.message})
+
+			return r, tea.Batch(cmd, tea.Tick(TOAST_DURATIONS[msg.level], func(t time.Time) tea.Msg {
+				return toastExpiredMsg{id: id}
+			}))
+		}
+	case toastExpiredMsg:
+		{
+			var toasts []*Toast
+			for _, toast := range r.toasts {
+				if toast.id != msg.id {
+					toasts = append(toasts, toast)
+				}
+			}
+			r.toasts = toasts
+		}
+	case openScreenMsg:
+		{
+			if !isScreen(msg.screen) {
+				return r, tea.Batch(cmd, notify(TOAST_ERROR, fmt.Sprintf("could not open unknown screen %v", msg.screen)))
+			}
+
+			r.currentScreen = msg.screen
+
+			if msg.screen == SCREEN_PULL_REQUEST_DETAILS {
+				return r, tea.Batch(cmd, r.PullRequestDetailsScreen.Open(msg.pullRequest))
+			}
+
+			if msg.screen == SCREEN_DIFF {
+				return r, tea.Batch(cmd, r.DiffScreen.Open(msg.pullRequest))
+			}
+
+			if msg.screen == SCREEN_REVIEW_THREADS {
+				return r, tea.Batch(cmd, r.ReviewThreadsScreen.Open(msg.pullRequest))
+			}
+
+			if msg.screen == SCREEN_CHECKS {
+				return r, tea.Batch(cmd, r.ChecksScreen.Open(msg.pullRequest))
+			}
+		}
 	case tea.KeyMsg:
 		switch msg.String() {
 		case helpSwitchToSettingsScreen.Shortcut:
@@ -64,33 +215,46 @@ func (r *Router) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case helpQuit.Shortcut:
 			{
+				r.GithubApi.CancelRequests()
 				return r, tea.Quit
 			}
 		}
-	case tea.WindowSizeMsg:
-		{
-			r.Logger.Info(fmt.Sprintf("window width is set to %v", strconv.Itoa(msg.Width)))
-			r.Logger.Info(fmt.Sprintf("window height is set to %v", strconv.Itoa(msg.Height)))
-			r.Window.Height = msg.Height
-			r.Window.Width = msg.Width
-
-			StyledHeader.Width(msg.Width - lipgloss.RoundedBorder().GetLeftSize() - lipgloss.RoundedBorder().GetRightSize() - StyledMain.GetHorizontalPadding())
-		}
 	}
 
 	return r, cmd
 }
 
 func (r *Router) View() string {
-	if r.currentScreen == SCREEN_SETTINGS {
-		return r.SettingsScreen.View()
+	var view string
+	switch r.currentScreen {
+	case SCREEN_SETTINGS:
+		view = r.SettingsScreen.View()
+	case SCREEN_PULL_REQUESTS:
+		view = r.PullRequestsScreen.View()
+	case SCREEN_PULL_REQUEST_DETAILS:
+		view = r.PullRequestDetailsScreen.View()
+	case SCREEN_DIFF:
+		view = r.DiffScreen.View()
+	case SCREEN_REVIEW_THREADS:
+		view = r.ReviewThreadsScreen.View()
+	case SCREEN_CHECKS:
+		view = r.ChecksScreen.View()
+	default:
+		// Screens are only switched to after they are validated, so this is not expected to happen. The app stays
+		// usable anyway, because pull requests are shown instead.
+		r.Logger.Error(fmt.Errorf("incorrect screen name %v", r.currentScreen))
+		view = r.PullRequestsScreen.View()
 	}
 
-	if r.currentScreen == SCREEN_PULL_REQUESTS {
-		return r.PullRequestsScreen.View()
+	status := renderStatusBar(r.GithubApi.RateLimiter, time.Now())
+	if len(r.toasts) > 0 {
+		status = r.toasts[len(r.toasts)-1].View()
 	}
 
-	panic(fmt.Sprintf("incorrect screen name %v", r.currentScreen))
+	// Screens shorter than the window are padded, so that the status bar stays at the bottom.
+	statusBar := lipgloss.NewStyle().PaddingLeft(StyledMain.GetPaddingLeft()).Render(fitWidth(status, r.Window.Width-StyledMain.GetHorizontalPadding()))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lipgloss.PlaceVertical(r.Window.Height, lipgloss.Top, view), statusBar)
 }
 
 func main() {
@@ -99,17 +263,36 @@ func main() {
 	settingsInstance := NewSettings(logger)
 	settingsInstance.Load()
 
-	gitHubApi := NewGithubApi(settingsInstance.GithubToken)
+	drafts := NewDrafts(logger)
+	draftsErr := drafts.Load()
+
+	gitHubApi := NewGithubApi(settingsInstance.GithubToken, settingsInstance, logger)
 
 	globalState := NewWindow()
 
 	settingsScreen := NewSettingsScreen(globalState, settingsInstance, logger, gitHubApi)
 
-	pullRequestsScreen := NewPullRequestsScreen(globalState, settingsInstance, logger, gitHubApi)
+	pullRequestsScreen := NewPullRequestsScreen(globalState, settingsInstance, logger, gitHubApi, drafts)
+
+	pullRequestDetailsScreen := NewPullRequestDetailsScreen(globalState, settingsInstance, logger, gitHubApi)
+
+	diffScreen := NewDiffScreen(globalState, settingsInstance, logger, gitHubApi, drafts)
+
+	reviewThreadsScreen := NewReviewThreadsScreen(globalState, settingsInstance, logger, gitHubApi)
 
-	router := NewRouter(settingsScreen, pullRequestsScreen, globalState, settingsInstance, logger)
+	checksScreen := NewChecksScreen(globalState, settingsInstance, logger, gitHubApi)
+
+	router := NewRouter(settingsScreen, pullRequestsScreen, pullRequestDetailsScreen, diffScreen, reviewThreadsScreen, checksScreen, globalState, settingsInstance, logger, gitHubApi)
 
 	program := tea.NewProgram(router, tea.WithAltScreen())
+
+	// The app works without drafts, so instead of stopping it the user is warned once it is running.
+	if draftsErr != nil {
+		go program.Send(toastMsg{
+			level:   TOAST_WARNING,
+			message: fmt.Sprintf("could not load drafts, starting without them: %v", draftsErr),
+		})
+	}
 	if _, err := program.Run(); err != nil {
 		panic(err)
 	}
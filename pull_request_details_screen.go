@@ -0,0 +1,252 @@
+package main
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+	"strings"
+)
+
+var PULL_REQUEST_DETAILS_HELP = []Help{helpUp, helpDown, helpBack, helpOpenPullRequest}
+
+type PullRequestDetailsScreen struct {
+	*Window
+	*Settings
+	*Logger
+	*GithubApi
+	Viewport    viewport.Model
+	Spinner     spinner.Model
+	pullRequest *PullRequest
+	details     *getPullRequestDetailsNodePullRequest
+	err         error
+}
+
+func NewPullRequestDetailsScreen(globalState *Window, settings *Settings, logger *Logger, githubApi *GithubApi) *PullRequestDetailsScreen {
+	return &PullRequestDetailsScreen{
+		Window:    globalState,
+		Settings:  settings,
+		Logger:    logger,
+		GithubApi: githubApi,
+		Viewport:  viewport.New(0, 0),
+		Spinner:   spinner.New(spinner.WithSpinner(spinner.Dot), spinner.WithStyle(StyledSpinner)),
+	}
+}
+
+// pullRequestDetailsFetchedMsg is emitted once details of a pull request have been fetched.
+type pullRequestDetailsFetchedMsg struct {
+	id      string
+	details *getPullRequestDetailsNodePullRequest
+	err     error
+}
+
+func (r *PullRequestDetailsScreen) fetchDetails(id string) tea.Cmd {
+	return func() tea.Msg {
+		r.Logger.Info(fmt.Sprintf("fetching details of pull request %v", id))
+
+		response, err := getPullRequestDetails(r.GithubApi.Context(), *r.GithubApi.client, id)
+		if err != nil {
+			return pullRequestDetailsFetchedMsg{id: id, err: err}
+		}
+
+		details, ok := response.GetNode().(*getPullRequestDetailsNodePullRequest)
+		if !ok {
+			return pullRequestDetailsFetchedMsg{id: id, err: fmt.Errorf("node %v is not a pull request", id)}
+		}
+
+		return pullRequestDetailsFetchedMsg{id: id, details: details}
+	}
+}
+
+// Open shows details of the pull request, fetching them in the background.
+func (r *PullRequestDetailsScreen) Open(pullRequest *PullRequest) tea.Cmd {
+	r.pullRequest = pullRequest
+	r.details = nil
+	r.err = nil
+	r.resize()
+	r.Viewport.SetContent("")
+	r.Viewport.GotoTop()
+
+	return tea.Batch(r.Spinner.Tick, r.fetchDetails(pullRequest.GetId()))
+}
+
+func (r *PullRequestDetailsScreen) resize() {
+	help, _ := RenderHelp(PULL_REQUEST_DETAILS_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+
+	r.Viewport.Width = r.Window.Width - StyledMain.GetHorizontalPadding()
+	r.Viewport.Height = r.Window.Height - StyledMain.GetVerticalPadding() - lipgloss.Height(StyledHeader.Render("")) - lipgloss.Height(help)
+	if r.Viewport.Height < 1 {
+		r.Viewport.Height = 1
+	}
+}
+
+func (r *PullRequestDetailsScreen) renderReviewers() string {
+	reviewers := ""
+	for _, review := range r.details.GetLatestReviews().GetNodes() {
+		state := StyledCommented.Render("commented")
+		switch review.GetState() {
+		case PullRequestReviewStateApproved:
+			state = StyledApproved.Render("approved")
+		case PullRequestReviewStateChangesRequested:
+			state = StyledChangesRequested.Render("changes requested")
+		case PullRequestReviewStateDismissed:
+			state = StyledDraft.Render("dismissed")
+		}
+
+		reviewers += fmt.Sprintf("  • %v %v\n", review.GetAuthor().GetLogin(), state)
+	}
+
+	for _, reviewRequest := range r.details.GetReviewRequests().GetNodes() {
+		switch requestedReviewer := reviewRequest.GetRequestedReviewer().(type) {
+		case *ReviewRequestFieldsRequestedReviewerUser:
+			reviewers += fmt.Sprintf("  • %v %v\n", requestedReviewer.GetLogin(), StyledAwaiting.Render("review requested"))
+		case *ReviewRequestFieldsRequestedReviewerTeam:
+			reviewers += fmt.Sprintf("  • %v %v\n", requestedReviewer.GetCombinedSlug(), StyledTeamAwaiting.Render("review requested"))
+		}
+	}
+
+	if reviewers == "" {
+		return StyledDraft.Render("  none") + "\n"
+	}
+
+	return reviewers
+}
+
+func (r *PullRequestDetailsScreen) renderDetails() string {
+	width := r.Window.Width - StyledMain.GetHorizontalPadding()
+
+	var labels []string
+	for _, label := range r.details.GetLabels().GetNodes() {
+		labels = append(labels, lipgloss.NewStyle().Background(lipgloss.Color("#"+label.GetColor())).Foreground(ColorBlack).Padding(0, 1).Render(label.GetName()))
+	}
+	if len(labels) == 0 {
+		labels = append(labels, StyledDraft.Render("none"))
+	}
+
+	var assignees []string
+	for _, assignee := range r.details.GetAssignees().GetNodes() {
+		assignees = append(assignees, assignee.GetLogin())
+	}
+	if len(assignees) == 0 {
+		assignees = append(assignees, StyledDraft.Render("none"))
+	}
+
+	content := fmt.Sprintf("%v\n", StyledUnderline.Render(r.details.GetTitle()))
+	content += fmt.Sprintf("%v wants to merge %v into %v in %v\n", r.details.GetAuthor().GetLogin(), r.details.GetHeadRefName(), r.details.GetBaseRefName(), r.details.GetRepository().GetNameWithOwner())
+	content += fmt.Sprintf("%v %v in %v changed files\n\n", StyledApproved.Render(fmt.Sprintf("+%v", r.details.GetAdditions())), StyledChangesRequested.Render(fmt.Sprintf("-%v", r.details.GetDeletions())), r.details.GetChangedFiles())
+	content += fmt.Sprintf("Labels: %v\n", strings.Join(labels, " "))
+	content += fmt.Sprintf("Assignees: %v\n", strings.Join(assignees, ", "))
+	content += fmt.Sprintf("Reviewers:\n%v\n", r.renderReviewers())
+
+	body := r.details.GetBody()
+	if strings.TrimSpace(body) == "" {
+		body = "_No description provided._"
+	}
+
+	renderer, err := glamour.NewTermRenderer(glamour.WithStandardStyle("dark"), glamour.WithWordWrap(width-4))
+	if err != nil {
+		r.Logger.Error(err)
+		return content + body
+	}
+
+	renderedBody, err := renderer.Render(body)
+	if err != nil {
+		r.Logger.Error(err)
+		return content + body
+	}
+
+	return content + renderedBody
+}
+
+func (r *PullRequestDetailsScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (r *PullRequestDetailsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		{
+			if r.pullRequest != nil && r.details == nil && r.err == nil {
+				r.Spinner, cmd = r.Spinner.Update(msg)
+			}
+		}
+	case tea.WindowSizeMsg:
+		{
+			r.resize()
+			if r.details != nil {
+				r.Viewport.SetContent(r.renderDetails())
+			}
+		}
+	case pullRequestDetailsFetchedMsg:
+		{
+			if r.pullRequest == nil || msg.id != r.pullRequest.GetId() {
+				break
+			}
+
+			if msg.err != nil {
+				r.Logger.Info(fmt.Sprintf("could not fetch details of pull request %v", msg.id))
+				r.Logger.Error(msg.err)
+
+				r.err = msg.err
+				break
+			}
+
+			r.details = msg.details
+			r.Viewport.SetContent(r.renderDetails())
+		}
+	case tea.KeyMsg:
+		{
+			switch msg.String() {
+			case helpBack.Shortcut:
+				{
+					return r, openScreen(SCREEN_PULL_REQUESTS, nil)
+				}
+			case helpOpenPullRequest.Shortcut:
+				{
+					if r.pullRequest == nil {
+						break
+					}
+
+					if err := openUrl(r.pullRequest.GetUrl()); err != nil {
+						cmd = notifyError("could not open pull request in browser", err)
+					}
+				}
+			default:
+				{
+					r.Viewport, cmd = r.Viewport.Update(msg)
+				}
+			}
+		}
+	}
+
+	return r, cmd
+}
+
+func (r *PullRequestDetailsScreen) View() string {
+	title := "Pull request"
+	if r.pullRequest != nil {
+		title = fmt.Sprintf("Pull request #%v", r.pullRequest.GetNumber())
+	}
+	header := StyledHeader.Render(title)
+
+	var content string
+	switch {
+	case r.err != nil:
+		content = StyledChangesRequested.Render(fmt.Sprintf("Could not fetch pull request details: %v", r.err))
+	case r.details == nil:
+		content = fmt.Sprintf("%v Loading pull request details...", r.Spinner.View())
+	default:
+		content = r.Viewport.View()
+	}
+
+	help, err := RenderHelp(PULL_REQUEST_DETAILS_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+	if err != nil {
+		r.Logger.Error(err)
+	}
+
+	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, header, content, help))
+}
@@ -3,53 +3,200 @@ package main
 import (
 	"context"
 	"fmt"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/reflow/wordwrap"
-	"os/exec"
-	"runtime"
+	"math"
 	"sort"
 	"strings"
+	"time"
 )
 
-var PULL_REQUESTS_HELP = []Help{helpUp, helpDown, helpSwitchToSettingsScreen, helpOpenAllActivePullRequests, helpOpenPullRequest}
+var PULL_REQUESTS_HELP = []Help{helpUp, helpDown, helpSwitchToSettingsScreen, helpOpenAllActivePullRequests, helpOpenPullRequest, helpRefreshPullRequests, helpShowPullRequestDetails, helpShowDiff, helpReviewPullRequest, helpShowReviewThreads, helpShowChecks, helpSwitchPullRequestsTab, helpSearchPullRequests, helpClearFilter, helpSwitchSortMode, helpToggleGroupByRepository, helpPageUp, helpPageDown, helpGoToTop, helpGoToBottom}
+
+// GROUP_HELP is displayed in addition to PULL_REQUESTS_HELP when pull requests are grouped by repository.
+var GROUP_HELP = []Help{helpToggleRepositoryGroup, helpNextRepositoryGroup, helpPreviousRepositoryGroup}
+
+var REVIEW_HELP = []Help{helpSwitchReviewEvent, helpSubmitReview, helpEscape}
+
+var SEARCH_HELP = []Help{helpApplyFilter, helpClearFilter}
+
+// REVIEW_EVENTS are verdicts that can be chosen in the review composer, in the order they are switched.
+var REVIEW_EVENTS = []PullRequestReviewEvent{PullRequestReviewEventApprove, PullRequestReviewEventRequestChanges, PullRequestReviewEventComment}
+
+const (
+	COMPOSE_REVIEW string = "COMPOSE_REVIEW"
+	SUBMIT_REVIEW  string = "SUBMIT_REVIEW"
+	SEARCH         string = "SEARCH"
+)
+
+// Pull requests screen lists either pull requests I am asked to review or pull requests I authored.
+const (
+	TAB_REVIEW_REQUESTS  string = "TAB_REVIEW_REQUESTS"
+	TAB_MY_PULL_REQUESTS string = "TAB_MY_PULL_REQUESTS"
+)
 
 type PullRequestsScreen struct {
 	*Window
 	*Settings
 	*Logger
 	*GithubApi
+	*Drafts
+	Spinner                  spinner.Model
+	Composer                 textarea.Model
+	SearchInput              textinput.Model
+	ListViewport             *ListViewport
+	filter                   string
+	collapsedRepositories    map[string]bool
+	state                    string
+	tab                      string
+	reviewPullRequest        *PullRequest
+	reviewEventIndex         int
+	reviewErr                error
 	pullRequests             []*PullRequest
+	repositoryPullRequests   map[string][]*PullRequestFields
+	repositoryStates         map[string]int
+	repositoryErrors         map[string]error
+	discoveredPullRequests   []*PullRequestFields
+	discoveryState           int
+	teams                    map[string]bool
+	fetchId                  int
+	fetchLogin               string
+	refreshTickId            int
+	cancelFetch              context.CancelFunc
+	isPostponed              bool
 	SelectedPullRequestIndex int
 }
 
 type PullRequest struct {
-	*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest
+	*PullRequestFields
 	order int
+	// isFromUnwatchedRepository is set for pull requests found by discovery in repositories that are not watched.
+	isFromUnwatchedRepository bool
 }
 
-func NewPullRequestsScreen(globalState *Window, settings *Settings, logger *Logger, githubApi *GithubApi) *PullRequestsScreen {
+func NewPullRequestsScreen(globalState *Window, settings *Settings, logger *Logger, githubApi *GithubApi, drafts *Drafts) *PullRequestsScreen {
+	composer := textarea.New()
+	composer.Placeholder = "Leave a comment..."
+	composer.ShowLineNumbers = false
+	composer.CharLimit = 0
+
+	searchInput := textinput.New()
+	searchInput.Placeholder = "state:awaiting repo:api author:alice label:urgent or any text"
+	searchInput.Prompt = "/ "
+	searchInput.CharLimit = 200
+
 	return &PullRequestsScreen{
-		Window:    globalState,
-		Settings:  settings,
-		Logger:    logger,
-		GithubApi: githubApi,
+		Window:                 globalState,
+		Settings:               settings,
+		Logger:                 logger,
+		GithubApi:              githubApi,
+		Drafts:                 drafts,
+		Spinner:                spinner.New(spinner.WithSpinner(spinner.Dot), spinner.WithStyle(StyledSpinner)),
+		Composer:               composer,
+		SearchInput:            searchInput,
+		ListViewport:           NewListViewport(),
+		state:                  DEFAULT,
+		tab:                    TAB_REVIEW_REQUESTS,
+		repositoryPullRequests: map[string][]*PullRequestFields{},
+		repositoryStates:       map[string]int{},
+		repositoryErrors:       map[string]error{},
+		collapsedRepositories:  map[string]bool{},
+		teams:                  map[string]bool{},
 	}
 }
 
 const (
-	PULL_REQUEST_AWAITING  = 1
-	PULL_REQUEST_REJECTED  = 2
-	PULL_REQUEST_COMMENTED = 3
-	PULL_REQUEST_APPROVED  = 4
-	PULL_REQUEST_DRAFT     = 5
+	PULL_REQUEST_AWAITING      = 1
+	PULL_REQUEST_TEAM_AWAITING = 2
+	PULL_REQUEST_NEW_COMMITS   = 3
+	PULL_REQUEST_REJECTED      = 4
+	PULL_REQUEST_COMMENTED     = 5
+	PULL_REQUEST_APPROVED      = 6
+	PULL_REQUEST_DRAFT         = 7
+)
+
+// Pull requests I authored are ordered by what they are waiting for, the ones requiring my action first.
+const (
+	MY_PULL_REQUEST_CHANGES_REQUESTED = 1
+	MY_PULL_REQUEST_REVIEW_REQUIRED   = 2
+	MY_PULL_REQUEST_APPROVED          = 3
+	MY_PULL_REQUEST_DRAFT             = 4
+)
+
+// PULL_REQUEST_STATE_NAMES are names of states that pull requests can be filtered by with state:name.
+var PULL_REQUEST_STATE_NAMES = map[int]string{
+	PULL_REQUEST_AWAITING:      "awaiting",
+	PULL_REQUEST_TEAM_AWAITING: "team-awaiting",
+	PULL_REQUEST_NEW_COMMITS:   "new-commits",
+	PULL_REQUEST_REJECTED:      "changes-requested",
+	PULL_REQUEST_COMMENTED:     "commented",
+	PULL_REQUEST_APPROVED:      "approved",
+	PULL_REQUEST_DRAFT:         "draft",
+}
+
+var MY_PULL_REQUEST_STATE_NAMES = map[int]string{
+	MY_PULL_REQUEST_CHANGES_REQUESTED: "changes-requested",
+	MY_PULL_REQUEST_REVIEW_REQUIRED:   "review-required",
+	MY_PULL_REQUEST_APPROVED:          "approved",
+	MY_PULL_REQUEST_DRAFT:             "draft",
+}
+
+// DISCOVERY_QUERY finds open pull requests requesting my review in any repository.
+const DISCOVERY_QUERY = "is:pr is:open archived:false review-requested:@me"
+
+// MY_PULL_REQUESTS_DISCOVERY_QUERY finds open pull requests I authored in any repository.
+const MY_PULL_REQUESTS_DISCOVERY_QUERY = "is:pr is:open archived:false author:@me"
+
+// DISCOVERY_LABEL stands for all discovery queries in the list of what is being loaded, since they run one after
+// another as a single step.
+const DISCOVERY_LABEL = "discovering pull requests in all repositories"
+
+const (
+	REPOSITORY_LOADING = 1
+	REPOSITORY_LOADED  = 2
+	REPOSITORY_FAILED  = 3
 )
 
-func mapGithubPullRequestsToApplicationPullRequests(githubPullRequests []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest, user string) []*PullRequest {
+// hasNewCommitsSinceReview reports whether the head of the pull request moved since the review was submitted.
+func hasNewCommitsSinceReview(pullRequest *PullRequestFields, review *PullRequestReviewFields) bool {
+	if review.GetCommit() == nil || pullRequest.GetHeadRefOid() == "" {
+		return false
+	}
+
+	return review.GetCommit().GetOid() != pullRequest.GetHeadRefOid()
+}
+
+// getHeadCommittedDate returns the date of the latest commit of the pull request, or its creation date if commits
+// are not available.
+func getHeadCommittedDate(pullRequest *PullRequestFields) time.Time {
+	commits := pullRequest.GetCommits().GetNodes()
+	if len(commits) == 0 {
+		return pullRequest.GetCreatedAt()
+	}
+
+	return commits[len(commits)-1].GetCommit().GetCommittedDate()
+}
+
+// isRequestedFromTeam reports whether the review request is addressed to one of the teams, identified by their
+// combined slugs (e.g. org/backend).
+func isRequestedFromTeam(reviewRequest *ReviewRequestFields, teams map[string]bool) bool {
+	requestedTeam, ok := reviewRequest.GetRequestedReviewer().(*ReviewRequestFieldsRequestedReviewerTeam)
+	if !ok {
+		return false
+	}
+
+	return teams[requestedTeam.GetCombinedSlug()]
+}
+
+func mapGithubPullRequestsToApplicationPullRequests(githubPullRequests []*PullRequestFields, user string, teams map[string]bool) []*PullRequest {
 	var applicationPullRequests []*PullRequest
 	for _, githubPullRequest := range githubPullRequests {
 		pullRequest := PullRequest{
-			getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest: githubPullRequest,
+			PullRequestFields: githubPullRequest,
 		}
 
 		if githubPullRequest.GetIsDraft() == true {
@@ -64,11 +211,23 @@ func mapGithubPullRequestsToApplicationPullRequests(githubPullRequests []*getRep
 					} else if latestReviews.GetState() == PullRequestReviewStateCommented {
 						pullRequest.order = PULL_REQUEST_COMMENTED
 					}
+
+					if pullRequest.order != 0 && hasNewCommitsSinceReview(githubPullRequest, latestReviews) {
+						pullRequest.order = PULL_REQUEST_NEW_COMMITS
+					}
+				}
+			}
+
+			// Team review requests stay pending until any member of the team reviews, so my own review takes
+			// precedence over them.
+			for _, reviewRequest := range githubPullRequest.GetReviewRequests().GetNodes() {
+				if pullRequest.order == 0 && isRequestedFromTeam(reviewRequest, teams) {
+					pullRequest.order = PULL_REQUEST_TEAM_AWAITING
 				}
 			}
 
 			for _, reviewRequest := range githubPullRequest.GetReviewRequests().GetNodes() {
-				requestedReviewer, ok := reviewRequest.GetRequestedReviewer().(*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser)
+				requestedReviewer, ok := reviewRequest.GetRequestedReviewer().(*ReviewRequestFieldsRequestedReviewerUser)
 				if ok {
 					if requestedReviewer.GetLogin() == user {
 						pullRequest.order = PULL_REQUEST_AWAITING
@@ -83,19 +242,107 @@ func mapGithubPullRequestsToApplicationPullRequests(githubPullRequests []*getRep
 	return applicationPullRequests
 }
 
-func getGithubPullRequestsFromRepositories(repositoryInfoResponses []*getRepositoryInfoResponse) []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest {
-	var pullRequests []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest
+func getGithubPullRequestsFromRepositories(repositoryPullRequests [][]*PullRequestFields) []*PullRequestFields {
+	var pullRequests []*PullRequestFields
 
-	for _, repositoryInfoResponse := range repositoryInfoResponses {
-		pullRequests = append(pullRequests, repositoryInfoResponse.GetRepository().GetPullRequests().GetNodes()...)
+	for _, pullRequestsFromRepository := range repositoryPullRequests {
+		pullRequests = append(pullRequests, pullRequestsFromRepository...)
 	}
 
 	return pullRequests
 }
 
-func sortPullRequestsForMe(pullRequestsForMe []*PullRequest, logger *Logger, username string) {
+// mergeDiscoveredPullRequests appends discovered pull requests that are not already present among pull requests
+// fetched from watched repositories.
+func mergeDiscoveredPullRequests(pullRequests []*PullRequestFields, discoveredPullRequests []*PullRequestFields) []*PullRequestFields {
+	ids := map[string]bool{}
+	for _, pullRequest := range pullRequests {
+		ids[pullRequest.GetId()] = true
+	}
+
+	merged := pullRequests
+	for _, discoveredPullRequest := range discoveredPullRequests {
+		if ids[discoveredPullRequest.GetId()] {
+			continue
+		}
+
+		ids[discoveredPullRequest.GetId()] = true
+		merged = append(merged, discoveredPullRequest)
+	}
+
+	return merged
+}
+
+// getChecksState returns the combined state of checks and statuses of the latest commit of the pull request, or an
+// empty state if the commit has none.
+func getChecksState(pullRequest *PullRequestFields) StatusState {
+	commits := pullRequest.GetCommits().GetNodes()
+	if len(commits) == 0 || commits[len(commits)-1].GetCommit().GetStatusCheckRollup() == nil {
+		return ""
+	}
+
+	return commits[len(commits)-1].GetCommit().GetStatusCheckRollup().GetState()
+}
+
+func hasFailingChecks(pullRequest *PullRequestFields) bool {
+	state := getChecksState(pullRequest)
+
+	return state == StatusStateFailure || state == StatusStateError
+}
+
+// comparePullRequests compares pull requests by the sort mode. It returns a negative number when a goes first, a
+// positive number when b goes first and 0 when the sort mode does not tell them apart, in which case they are ordered
+// by their state. Sorting by state alone always returns 0.
+func comparePullRequests(a *PullRequest, b *PullRequest, sortMode string) int {
+	switch sortMode {
+	case SORT_MODE_AGE:
+		return a.GetCreatedAt().Compare(b.GetCreatedAt())
+	case SORT_MODE_UPDATED:
+		return b.GetUpdatedAt().Compare(a.GetUpdatedAt())
+	case SORT_MODE_REPOSITORY:
+		return strings.Compare(strings.ToLower(a.GetRepository().GetNameWithOwner()), strings.ToLower(b.GetRepository().GetNameWithOwner()))
+	case SORT_MODE_AUTHOR:
+		return strings.Compare(strings.ToLower(a.GetAuthor().GetLogin()), strings.ToLower(b.GetAuthor().GetLogin()))
+	case SORT_MODE_SIZE:
+		// Small pull requests go first, since they can be reviewed in a moment.
+		return (a.GetAdditions() + a.GetDeletions()) - (b.GetAdditions() + b.GetDeletions())
+	}
+
+	return 0
+}
+
+// sortPullRequestsForMe sorts pull requests by the sort mode, falling back to their state. Depending on failingChecks,
+// pull requests with failing checks are either sorted like any other, moved to the end of the list or removed from it.
+func sortPullRequestsForMe(pullRequestsForMe []*PullRequest, logger *Logger, username string, failingChecks string, sortMode string) []*PullRequest {
+	if failingChecks == FAILING_CHECKS_HIDE {
+		var passingPullRequests []*PullRequest
+		for _, pullRequest := range pullRequestsForMe {
+			if !hasFailingChecks(pullRequest.PullRequestFields) {
+				passingPullRequests = append(passingPullRequests, pullRequest)
+			}
+		}
+		pullRequestsForMe = passingPullRequests
+	}
+
 	sort.Slice(pullRequestsForMe, func(i, j int) bool {
+		if failingChecks == FAILING_CHECKS_DEPRIORITIZE {
+			iFailing := hasFailingChecks(pullRequestsForMe[i].PullRequestFields)
+			jFailing := hasFailingChecks(pullRequestsForMe[j].PullRequestFields)
+			if iFailing != jFailing {
+				return jFailing
+			}
+		}
+
+		if compared := comparePullRequests(pullRequestsForMe[i], pullRequestsForMe[j], sortMode); compared != 0 {
+			return compared < 0
+		}
+
 		if pullRequestsForMe[i].order == pullRequestsForMe[j].order {
+			// Pull requests with the most recently pushed commits are the ones I most likely still remember.
+			if pullRequestsForMe[i].order == PULL_REQUEST_NEW_COMMITS {
+				return getHeadCommittedDate(pullRequestsForMe[i].PullRequestFields).After(getHeadCommittedDate(pullRequestsForMe[j].PullRequestFields))
+			}
+
 			return pullRequestsForMe[i].GetCreatedAt().After(pullRequestsForMe[j].GetCreatedAt())
 		}
 
@@ -105,10 +352,12 @@ func sortPullRequestsForMe(pullRequestsForMe []*PullRequest, logger *Logger, use
 
 		return true
 	})
+
+	return pullRequestsForMe
 }
 
-func findPullRequestsForMe(pullRequests []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest, user string) []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest {
-	var final []*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequest
+func findPullRequestsForMe(pullRequests []*PullRequestFields, user string, teams map[string]bool) []*PullRequestFields {
+	var final []*PullRequestFields
 	for _, pullRequest := range pullRequests {
 		isSubmittedByMe := false
 		isRequestingMyReview := false
@@ -119,12 +368,16 @@ func findPullRequestsForMe(pullRequests []*getRepositoryInfoRepositoryPullReques
 		}
 
 		for _, reviewRequest := range pullRequest.GetReviewRequests().GetNodes() {
-			requestedReviewer, ok := reviewRequest.GetRequestedReviewer().(*getRepositoryInfoRepositoryPullRequestsPullRequestConnectionNodesPullRequestReviewRequestsReviewRequestConnectionNodesReviewRequestRequestedReviewerUser)
+			requestedReviewer, ok := reviewRequest.GetRequestedReviewer().(*ReviewRequestFieldsRequestedReviewerUser)
 			if ok {
 				if requestedReviewer.GetLogin() == user {
 					isRequestingMyReview = true
 				}
 			}
+
+			if isRequestedFromTeam(reviewRequest, teams) {
+				isRequestingMyReview = true
+			}
 		}
 
 		for _, x := range pullRequest.GetLatestReviews().GetNodes() {
@@ -141,185 +394,1623 @@ func findPullRequestsForMe(pullRequests []*getRepositoryInfoRepositoryPullReques
 	return final
 }
 
-func (r *PullRequestsScreen) Init() tea.Cmd {
-	if r.Settings.GithubToken == "" {
-		return nil
+func findPullRequestsSubmittedByMe(pullRequests []*PullRequestFields, user string) []*PullRequestFields {
+	var final []*PullRequestFields
+	for _, pullRequest := range pullRequests {
+		if user != "" && pullRequest.GetAuthor().GetLogin() == user {
+			final = append(final, pullRequest)
+		}
 	}
 
-	channel := make(chan *getRepositoryInfoResponse)
-	responses := make([]*getRepositoryInfoResponse, len(r.Settings.Repositories))
+	return final
+}
 
-	for _, repositoryUrl := range r.Settings.Repositories {
-		go func(repositoryUrl string) {
-			urlParts := strings.Split(repositoryUrl, "/")
-			username := urlParts[len(urlParts)-2]
-			repositoryName := urlParts[len(urlParts)-1]
+// getReviewDecision returns the review decision of the pull request. GitHub reports it only for repositories which
+// require reviews, otherwise it is derived from the latest reviews.
+func getReviewDecision(pullRequest *PullRequestFields) PullRequestReviewDecision {
+	if pullRequest.GetReviewDecision() != "" {
+		return pullRequest.GetReviewDecision()
+	}
 
-			r.Logger.Info(fmt.Sprintf("sending request to %v/%v", username, repositoryName))
+	decision := PullRequestReviewDecisionReviewRequired
+	for _, review := range pullRequest.GetLatestReviews().GetNodes() {
+		if review.GetState() == PullRequestReviewStateChangesRequested {
+			return PullRequestReviewDecisionChangesRequested
+		}
 
-			var response *getRepositoryInfoResponse
-			var err error
-			response, err = getRepositoryInfo(context.Background(), *r.GithubApi.client, username, repositoryName)
-			if err != nil {
-				r.Logger.Info("error is not nil")
-				channel <- nil
+		if review.GetState() == PullRequestReviewStateApproved {
+			decision = PullRequestReviewDecisionApproved
+		}
+	}
 
-				r.Logger.Error(err)
+	return decision
+}
 
-				if strings.Contains(err.Error(), "401") {
-					r.Settings.UpdateGitHubToken("")
-				}
-			} else {
-				r.Logger.Info("passing response to channel")
-				channel <- response
-			}
-		}(repositoryUrl)
+// getReviewers returns who has been requested to review the pull request, who approved it and who requested changes.
+// Requested teams are identified by their combined slugs.
+func getReviewers(pullRequest *PullRequestFields) (requested []string, approved []string, changesRequested []string) {
+	for _, reviewRequest := range pullRequest.GetReviewRequests().GetNodes() {
+		switch requestedReviewer := reviewRequest.GetRequestedReviewer().(type) {
+		case *ReviewRequestFieldsRequestedReviewerUser:
+			requested = append(requested, requestedReviewer.GetLogin())
+		case *ReviewRequestFieldsRequestedReviewerTeam:
+			requested = append(requested, requestedReviewer.GetCombinedSlug())
+		}
 	}
 
-	for i := 0; i < len(r.Settings.Repositories); i++ {
-		responses[i] = <-channel
+	for _, review := range pullRequest.GetLatestReviews().GetNodes() {
+		switch review.GetState() {
+		case PullRequestReviewStateApproved:
+			approved = append(approved, review.GetAuthor().GetLogin())
+		case PullRequestReviewStateChangesRequested:
+			changesRequested = append(changesRequested, review.GetAuthor().GetLogin())
+		}
 	}
 
-	allPullRequestsFromWatchedRepositories := getGithubPullRequestsFromRepositories(responses)
+	return requested, approved, changesRequested
+}
 
-	// TODO: Take user from settings.
-	pullRequestsForMe := findPullRequestsForMe(allPullRequestsFromWatchedRepositories, r.Settings.Username)
+func mapMyPullRequests(githubPullRequests []*PullRequestFields) []*PullRequest {
+	reviewDecisionToOrder := map[PullRequestReviewDecision]int{
+		PullRequestReviewDecisionChangesRequested: MY_PULL_REQUEST_CHANGES_REQUESTED,
+		PullRequestReviewDecisionReviewRequired:   MY_PULL_REQUEST_REVIEW_REQUIRED,
+		PullRequestReviewDecisionApproved:         MY_PULL_REQUEST_APPROVED,
+	}
 
-	r.pullRequests = mapGithubPullRequestsToApplicationPullRequests(pullRequestsForMe, r.Settings.Username)
+	var applicationPullRequests []*PullRequest
+	for _, githubPullRequest := range githubPullRequests {
+		pullRequest := PullRequest{
+			PullRequestFields: githubPullRequest,
+			order:             reviewDecisionToOrder[getReviewDecision(githubPullRequest)],
+		}
 
-	sortPullRequestsForMe(r.pullRequests, r.Logger, r.Settings.Username)
+		if githubPullRequest.GetIsDraft() {
+			pullRequest.order = MY_PULL_REQUEST_DRAFT
+		}
 
-	r.Logger.Struct(pullRequestsForMe)
+		applicationPullRequests = append(applicationPullRequests, &pullRequest)
+	}
 
-	return nil
+	return applicationPullRequests
 }
 
-func (r *PullRequestsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		{
-			switch msg.String() {
-			case helpDown.Shortcut:
-				{
-					if r.SelectedPullRequestIndex == len(r.pullRequests)-1 {
-						r.SelectedPullRequestIndex = 0
-					} else {
-						r.SelectedPullRequestIndex = r.SelectedPullRequestIndex + 1
-					}
-				}
-			case helpUp.Shortcut:
-				{
-					if r.SelectedPullRequestIndex == 0 {
-						r.SelectedPullRequestIndex = len(r.pullRequests) - 1
-					} else {
-						r.SelectedPullRequestIndex = r.SelectedPullRequestIndex - 1
-					}
-				}
-			case helpOpenPullRequest.Shortcut:
-				{
-					selectedPullRequest := r.pullRequests[r.SelectedPullRequestIndex]
-					var err error
-					switch runtime.GOOS {
-					case "linux":
-						{
-							err = exec.Command("xdg-open", selectedPullRequest.GetUrl()).Start()
-						}
-					case "windows":
-						{
-							err = exec.Command("rundll32", "url.dll,FileProtocolHandler", selectedPullRequest.GetUrl()).Start()
-						}
-					case "darwin":
-						{
-							err = exec.Command("open", selectedPullRequest.GetUrl()).Start()
-						}
-					default:
-						err = fmt.Errorf("unsupported platform %v", runtime.GOOS)
-					}
+// sortMyPullRequests sorts pull requests I authored by the sort mode, falling back to their review decision and the
+// most recently created ones first.
+func sortMyPullRequests(myPullRequests []*PullRequest, sortMode string) []*PullRequest {
+	sort.Slice(myPullRequests, func(i, j int) bool {
+		if compared := comparePullRequests(myPullRequests[i], myPullRequests[j], sortMode); compared != 0 {
+			return compared < 0
+		}
 
-					if err != nil {
-						panic(err)
-					}
-				}
-			case helpOpenAllActivePullRequests.Shortcut:
-				{
-					for _, pullRequest := range r.pullRequests {
-						if pullRequest.order <= 3 {
-							var err error
-							switch runtime.GOOS {
-							case "linux":
-								{
-									err = exec.Command("xdg-open", pullRequest.GetUrl()).Start()
-								}
-							case "windows":
-								{
-									err = exec.Command("rundll32", "url.dll,FileProtocolHandler", pullRequest.GetUrl()).Start()
-								}
-							case "darwin":
-								{
-									err = exec.Command("open", pullRequest.GetUrl()).Start()
-								}
-							default:
-								err = fmt.Errorf("unsupported platform %v", runtime.GOOS)
-							}
+		if myPullRequests[i].order == myPullRequests[j].order {
+			return myPullRequests[i].GetCreatedAt().After(myPullRequests[j].GetCreatedAt())
+		}
 
-							if err != nil {
-								panic(err)
-							}
-						}
-					}
-				}
+		return myPullRequests[i].order < myPullRequests[j].order
+	})
+
+	return myPullRequests
+}
+
+// fetchRemainingPullRequests follows pull request pages of a single repository, starting from an already fetched first
+// page, until there are no more pages or the configured page limit is reached. Reviews and review requests that did
+// not fit into the first page of a pull request are fetched with dedicated queries.
+func (r *PullRequestsScreen) fetchRemainingPullRequests(ctx context.Context, owner string, name string, connection *getRepositoryInfoRepositoryPullRequestsPullRequestConnection) ([]*PullRequestFields, error) {
+	var pullRequests []*PullRequestFields
+
+	for page := 1; ; page++ {
+		for _, pullRequest := range connection.GetNodes() {
+			err := r.fetchRemainingLatestReviews(ctx, owner, name, pullRequest)
+			if err != nil {
+				return nil, err
 			}
+
+			err = r.fetchRemainingReviewRequests(ctx, owner, name, pullRequest)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		pullRequests = append(pullRequests, connection.GetNodes()...)
+
+		if !connection.GetPageInfo().GetHasNextPage() {
+			return pullRequests, nil
+		}
+
+		if page >= r.Settings.GetMaxPages() {
+			r.Logger.Info(fmt.Sprintf("reached page limit of %v while fetching pull requests from %v/%v", r.Settings.GetMaxPages(), owner, name))
+			return pullRequests, nil
+		}
+
+		response, err := getRepositoryInfo(ctx, *r.GithubApi.client, owner, name, connection.GetPageInfo().GetEndCursor())
+		if err != nil {
+			return nil, err
+		}
+
+		connection = response.GetRepository().GetPullRequests()
+	}
+}
+
+func (r *PullRequestsScreen) fetchRemainingLatestReviews(ctx context.Context, owner string, name string, pullRequest *PullRequestFields) error {
+	latestReviews := pullRequest.GetLatestReviews()
+
+	for page := 1; page < r.Settings.GetMaxPages() && latestReviews.GetPageInfo().GetHasNextPage(); page++ {
+		response, err := getPullRequestLatestReviews(ctx, *r.GithubApi.client, owner, name, pullRequest.GetNumber(), latestReviews.GetPageInfo().GetEndCursor())
+		if err != nil {
+			return err
 		}
+
+		connection := response.GetRepository().GetPullRequest().GetLatestReviews()
+		latestReviews.Nodes = append(latestReviews.Nodes, connection.GetNodes()...)
+		latestReviews.PageInfo = connection.GetPageInfo()
 	}
 
-	return r, nil
+	return nil
 }
 
-func (r *PullRequestsScreen) View() string {
-	header := StyledHeader.Render("Pull requests")
+func (r *PullRequestsScreen) fetchRemainingReviewRequests(ctx context.Context, owner string, name string, pullRequest *PullRequestFields) error {
+	reviewRequests := pullRequest.GetReviewRequests()
+
+	for page := 1; page < r.Settings.GetMaxPages() && reviewRequests.GetPageInfo().GetHasNextPage(); page++ {
+		response, err := getPullRequestReviewRequests(ctx, *r.GithubApi.client, owner, name, pullRequest.GetNumber(), reviewRequests.GetPageInfo().GetEndCursor())
+		if err != nil {
+			return err
+		}
 
-	pullRequestStateToUI := map[int]string{
-		PULL_REQUEST_AWAITING:  StyledAwaiting.Render("review required"),
-		PULL_REQUEST_APPROVED:  StyledApproved.Render("approved"),
-		PULL_REQUEST_REJECTED:  StyledChangesRequested.Render("changes requested"),
-		PULL_REQUEST_DRAFT:     StyledDraft.Render("draft"),
-		PULL_REQUEST_COMMENTED: StyledCommented.Render("commented"),
+		connection := response.GetRepository().GetPullRequest().GetReviewRequests()
+		reviewRequests.Nodes = append(reviewRequests.Nodes, connection.GetNodes()...)
+		reviewRequests.PageInfo = connection.GetPageInfo()
 	}
 
-	var pullRequestMessage string
-	if len(r.pullRequests) == 0 {
-		pullRequestMessage = "You do not have any pull requests yet.\n"
-	} else {
-		for i, pullRequest := range r.pullRequests {
-			info, ok := pullRequestStateToUI[pullRequest.order]
-			if !ok {
-				r.Logger.Info(fmt.Sprintf("info does not exist for pull request state %v", pullRequest.order))
+	return nil
+}
+
+// repositoryPullRequests holds pull requests fetched from a single repository, or the reason they could not be fetched.
+type repositoryPullRequests struct {
+	repositoryUrl string
+	pullRequests  []*PullRequestFields
+	err           error
+}
+
+// pullRequestsFetchedMsg is emitted once all pages of a chunk of repositories have been fetched.
+type pullRequestsFetchedMsg struct {
+	fetchId      int
+	repositories []repositoryPullRequests
+}
+
+// pullRequestsDiscoveredMsg is emitted once pull requests requesting my review have been searched for across all
+// repositories.
+type pullRequestsDiscoveredMsg struct {
+	fetchId      int
+	pullRequests []*PullRequestFields
+	err          error
+}
+
+// repositoryErrorsUpdatedMsg is emitted once results of fetching a chunk of repositories are known, so that the
+// settings screen can mark repositories that could not be fetched.
+type repositoryErrorsUpdatedMsg struct {
+	errors map[string]error
+}
+
+// teamsFetchedMsg is emitted once teams I am a member of have been fetched.
+type teamsFetchedMsg struct {
+	fetchId int
+	teams   []string
+	err     error
+}
+
+// discoveryToggledMsg is emitted when discovery of review requests is turned on or off in the settings screen.
+type discoveryToggledMsg struct{}
+
+// failingChecksSwitchedMsg is emitted when the way of displaying pull requests with failing checks is changed in the
+// settings screen.
+type failingChecksSwitchedMsg struct{}
+
+// refreshTickMsg triggers a background refresh. Ticks with an outdated id are ignored, so rescheduling a refresh never
+// results in more than one pending tick.
+type refreshTickMsg struct {
+	id int
+}
+
+// rateLimitResetMsg is emitted when a background refresh that was postponed because of rate limit can be resumed.
+type rateLimitResetMsg struct{}
+
+// refreshIntervalUpdatedMsg is emitted when the refresh interval is changed in the settings screen.
+type refreshIntervalUpdatedMsg struct{}
+
+func (r *PullRequestsScreen) scheduleRefresh() tea.Cmd {
+	r.refreshTickId++
+
+	if r.Settings.RefreshInterval <= 0 {
+		return nil
+	}
+
+	id := r.refreshTickId
+	return tea.Tick(time.Duration(r.Settings.RefreshInterval)*time.Second, func(t time.Time) tea.Msg {
+		return refreshTickMsg{id: id}
+	})
+}
+
+// fetchRepositories fetches first pages of all given repositories with a single request and then follows remaining
+// pages of each repository separately.
+func (r *PullRequestsScreen) fetchRepositories(ctx context.Context, fetchId int, repositoryUrls []string) tea.Cmd {
+	return func() tea.Msg {
+		r.Logger.Info(fmt.Sprintf("sending request to %v", strings.Join(repositoryUrls, ", ")))
+
+		repositories, failures := r.GithubApi.GetRepositoriesPullRequests(ctx, repositoryUrls)
+
+		msg := pullRequestsFetchedMsg{fetchId: fetchId}
+		for _, repositoryUrl := range repositoryUrls {
+			result := repositoryPullRequests{
+				repositoryUrl: repositoryUrl,
+				err:           failures[repositoryUrl],
 			}
 
-			if i == r.SelectedPullRequestIndex {
-				pullRequestMessage += StyledUnderline.Render(fmt.Sprintf("• %v wants to merge \"%v\"", pullRequest.GetAuthor().GetLogin(), pullRequest.GetTitle())) + " (" + info + ")\n"
-			} else {
-				pullRequestMessage += fmt.Sprintf("• %v wants to merge \"%v\" (%v)\n", pullRequest.GetAuthor().GetLogin(), pullRequest.GetTitle(), info)
+			if result.err == nil {
+				owner, name := ParseRepositoryUrl(repositoryUrl)
+				result.pullRequests, result.err = r.fetchRemainingPullRequests(ctx, owner, name, repositories[repositoryUrl].GetPullRequests())
 			}
+
+			msg.repositories = append(msg.repositories, result)
 		}
+
+		return msg
 	}
-	pullRequestsWrapper := wordwrap.NewWriter(r.Window.Width - StyledMain.GetHorizontalPadding())
-	pullRequestsWrapper.Breakpoints = []rune{' '}
-	_, err := pullRequestsWrapper.Write([]byte(pullRequestMessage))
-	if err != nil {
-		r.Logger.Error(err)
+}
+
+// searchPullRequests follows pages of pull requests matching the search query until there are no more pages or the
+// configured page limit is reached.
+func (r *PullRequestsScreen) searchPullRequests(ctx context.Context, query string) ([]*PullRequestFields, error) {
+	var pullRequests []*PullRequestFields
+
+	after := ""
+	for page := 0; page < r.Settings.GetMaxPages(); page++ {
+		response, err := searchPullRequests(ctx, *r.GithubApi.client, query, after)
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.GetSearch()
+		for _, node := range connection.GetNodes() {
+			searchResult, ok := node.(*searchPullRequestsSearchSearchResultItemConnectionNodesPullRequest)
+			if !ok {
+				continue
+			}
+
+			pullRequest := &searchResult.PullRequestFields
+			owner, name := ParseRepositoryUrl(pullRequest.GetRepository().GetUrl())
+
+			err = r.fetchRemainingLatestReviews(ctx, owner, name, pullRequest)
+			if err != nil {
+				return nil, err
+			}
+
+			err = r.fetchRemainingReviewRequests(ctx, owner, name, pullRequest)
+			if err != nil {
+				return nil, err
+			}
+
+			pullRequests = append(pullRequests, pullRequest)
+		}
+
+		if !connection.GetPageInfo().GetHasNextPage() {
+			return pullRequests, nil
+		}
+
+		after = connection.GetPageInfo().GetEndCursor()
 	}
 
-	helpString := ""
-	for _, help := range PULL_REQUESTS_HELP {
-		helpString += lipgloss.JoinHorizontal(lipgloss.Left, StyledHelpShortcut.Render(help.Display), " ", StyledHelpDescription.Render(help.Description), "   ")
+	r.Logger.Info(fmt.Sprintf("reached page limit of %v while searching for \"%v\"", r.Settings.GetMaxPages(), query))
+
+	return pullRequests, nil
+}
+
+// discoverPullRequests searches for pull requests requesting my review and my own pull requests in all repositories,
+// including the ones that are not watched.
+func (r *PullRequestsScreen) discoverPullRequests(ctx context.Context, fetchId int) tea.Cmd {
+	return func() tea.Msg {
+		var pullRequests []*PullRequestFields
+		for _, query := range []string{DISCOVERY_QUERY, MY_PULL_REQUESTS_DISCOVERY_QUERY} {
+			r.Logger.Info(fmt.Sprintf("searching for \"%v\"", query))
+
+			found, err := r.searchPullRequests(ctx, query)
+			if err != nil {
+				return pullRequestsDiscoveredMsg{fetchId: fetchId, err: err}
+			}
+
+			pullRequests = mergeDiscoveredPullRequests(pullRequests, found)
+		}
+
+		return pullRequestsDiscoveredMsg{
+			fetchId:      fetchId,
+			pullRequests: pullRequests,
+		}
 	}
-	helpWrapper := wordwrap.NewWriter(r.Window.Width - StyledMain.GetHorizontalPadding())
-	helpWrapper.Breakpoints = []rune{' '}
-	_, err = helpWrapper.Write([]byte(helpString))
-	if err != nil {
-		r.Logger.Error(err)
+}
+
+// fetchTeams finds teams I am a member of in all organizations I belong to, so that review requests addressed to these
+// teams can be recognized. Listing organizations requires the read:org scope.
+func (r *PullRequestsScreen) fetchTeams(ctx context.Context, fetchId int, login string) tea.Cmd {
+	return func() tea.Msg {
+		msg := teamsFetchedMsg{fetchId: fetchId}
+
+		after := ""
+		for page := 0; page < r.Settings.GetMaxPages(); page++ {
+			response, err := getViewerTeams(ctx, *r.GithubApi.client, login, after)
+			if err != nil {
+				msg.err = err
+				return msg
+			}
+
+			connection := response.GetViewer().GetOrganizations()
+			for _, organization := range connection.GetNodes() {
+				for _, team := range organization.GetTeams().GetNodes() {
+					msg.teams = append(msg.teams, team.GetCombinedSlug())
+				}
+			}
+
+			if !connection.GetPageInfo().GetHasNextPage() {
+				break
+			}
+
+			after = connection.GetPageInfo().GetEndCursor()
+		}
+
+		return msg
 	}
+}
+
+// refreshInBackground fetches pull requests unless the budget runs low. Fetching all repositories is the most expensive
+// thing the app does, so background refreshes wait instead of using up what is left for actions taken by the user, e.g.
+// reloading pull requests manually. Only one postponed refresh is kept.
+func (r *PullRequestsScreen) refreshInBackground() tea.Cmd {
+	if until := r.GithubApi.RateLimiter.PostponedUntil(time.Now()); !until.IsZero() {
+		if r.isPostponed {
+			return nil
+		}
+
+		r.Logger.Info(fmt.Sprintf("postponing refreshing pull requests until %v because of rate limit", until))
+		r.isPostponed = true
+		return tea.Tick(time.Until(until), func(t time.Time) tea.Msg {
+			return rateLimitResetMsg{}
+		})
+	}
+
+	return r.fetchPullRequests()
+}
+
+func (r *PullRequestsScreen) fetchPullRequests() tea.Cmd {
+	if r.Settings.GithubToken == "" {
+		return nil
+	}
+
+	// Results of the previous fetch would be ignored anyway, so its requests are canceled instead of being waited for.
+	if r.cancelFetch != nil {
+		r.cancelFetch()
+	}
+	ctx, cancel := context.WithCancel(r.GithubApi.Context())
+	r.cancelFetch = cancel
+
+	// Pull requests from the previous fetch are kept until fresh ones arrive, so refreshing does not empty the list.
+	r.fetchId++
+	r.fetchLogin = r.Settings.Username
+	r.repositoryStates = map[string]int{}
+
+	for _, repositoryUrl := range r.Settings.Repositories {
+		r.repositoryStates[repositoryUrl] = REPOSITORY_LOADING
+	}
+
+	cmds := []tea.Cmd{r.Spinner.Tick}
+	if r.Settings.Username != "" {
+		cmds = append(cmds, r.fetchTeams(ctx, r.fetchId, r.Settings.Username))
+	}
+
+	for _, repositoryUrls := range ChunkRepositoryUrls(r.Settings.Repositories) {
+		cmds = append(cmds, r.fetchRepositories(ctx, r.fetchId, repositoryUrls))
+	}
+
+	r.discoveryState = 0
+	if r.Settings.Discovery {
+		r.discoveryState = REPOSITORY_LOADING
+		cmds = append(cmds, r.discoverPullRequests(ctx, r.fetchId))
+	} else {
+		r.discoveredPullRequests = nil
+	}
+
+	return tea.Batch(cmds...)
+}
+
+func (r *PullRequestsScreen) isLoading() bool {
+	if r.discoveryState == REPOSITORY_LOADING {
+		return true
+	}
+
+	for _, state := range r.repositoryStates {
+		if state == REPOSITORY_LOADING {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *PullRequestsScreen) countLoadedRepositories() int {
+	loaded := 0
+	for _, state := range r.repositoryStates {
+		if state != REPOSITORY_LOADING {
+			loaded++
+		}
+	}
+
+	return loaded
+}
+
+// updatePullRequests rebuilds the list of displayed pull requests from all repositories fetched so far. Selected pull
+// request stays selected as long as it is still on the list.
+func (r *PullRequestsScreen) updatePullRequests() {
+	selectedPullRequestId := ""
+	if r.SelectedPullRequestIndex < len(r.pullRequests) {
+		selectedPullRequestId = r.pullRequests[r.SelectedPullRequestIndex].GetId()
+	}
+
+	var responses [][]*PullRequestFields
+	for _, repositoryUrl := range r.Settings.Repositories {
+		responses = append(responses, r.repositoryPullRequests[repositoryUrl])
+	}
+
+	allPullRequestsFromWatchedRepositories := getGithubPullRequestsFromRepositories(responses)
+
+	allPullRequests := mergeDiscoveredPullRequests(allPullRequestsFromWatchedRepositories, r.discoveredPullRequests)
+
+	if r.tab == TAB_MY_PULL_REQUESTS {
+		myPullRequests := findPullRequestsSubmittedByMe(allPullRequests, r.Settings.Username)
+
+		r.pullRequests = mapMyPullRequests(myPullRequests)
+	} else {
+		pullRequestsForMe := findPullRequestsForMe(allPullRequests, r.Settings.Username, r.teams)
+
+		r.pullRequests = mapGithubPullRequestsToApplicationPullRequests(pullRequestsForMe, r.Settings.Username, r.teams)
+	}
+
+	for _, pullRequest := range r.pullRequests {
+		pullRequest.isFromUnwatchedRepository = !r.Settings.IsWatchedRepository(pullRequest.GetRepository().GetUrl())
+	}
+
+	if r.tab == TAB_MY_PULL_REQUESTS {
+		r.pullRequests = sortMyPullRequests(r.pullRequests, r.Settings.GetSortMode())
+	} else {
+		r.pullRequests = sortPullRequestsForMe(r.pullRequests, r.Logger, r.Settings.Username, r.Settings.GetFailingChecks(), r.Settings.GetSortMode())
+	}
+
+	filter := ParsePullRequestFilter(r.filter)
+	if !filter.IsEmpty() {
+		stateNames := PULL_REQUEST_STATE_NAMES
+		if r.tab == TAB_MY_PULL_REQUESTS {
+			stateNames = MY_PULL_REQUEST_STATE_NAMES
+		}
+
+		var filteredPullRequests []*PullRequest
+		for _, pullRequest := range r.pullRequests {
+			if filter.matches(pullRequest.PullRequestFields, stateNames[pullRequest.order]) {
+				filteredPullRequests = append(filteredPullRequests, pullRequest)
+			}
+		}
+		r.pullRequests = filteredPullRequests
+	}
+
+	if r.Settings.GroupByRepository {
+		r.pullRequests = groupPullRequestsByRepository(r.pullRequests)
+	}
+
+	for i, pullRequest := range r.pullRequests {
+		if pullRequest.GetId() == selectedPullRequestId {
+			r.SelectedPullRequestIndex = i
+		}
+	}
+
+	if r.SelectedPullRequestIndex >= len(r.pullRequests) {
+		r.SelectedPullRequestIndex = int(math.Max(float64(len(r.pullRequests)-1), float64(0)))
+	}
+
+	if len(r.pullRequests) > 0 && r.isCollapsed(r.SelectedPullRequestIndex) {
+		r.SelectedPullRequestIndex = r.findGroupStart(r.SelectedPullRequestIndex)
+	}
+}
+
+// getRepositoryGroup returns the name of the group the pull request is listed under when pull requests are grouped.
+func getRepositoryGroup(pullRequest *PullRequest) string {
+	return pullRequest.GetRepository().GetNameWithOwner()
+}
+
+// groupPullRequestsByRepository moves pull requests of the same repository next to each other. Groups are ordered by
+// their first pull request, so the sort order is kept both between and within groups.
+func groupPullRequestsByRepository(pullRequests []*PullRequest) []*PullRequest {
+	groupOrder := map[string]int{}
+	for i, pullRequest := range pullRequests {
+		if _, ok := groupOrder[getRepositoryGroup(pullRequest)]; !ok {
+			groupOrder[getRepositoryGroup(pullRequest)] = i
+		}
+	}
+
+	sort.SliceStable(pullRequests, func(i, j int) bool {
+		return groupOrder[getRepositoryGroup(pullRequests[i])] < groupOrder[getRepositoryGroup(pullRequests[j])]
+	})
+
+	return pullRequests
+}
+
+func (r *PullRequestsScreen) isCollapsed(index int) bool {
+	return r.Settings.GroupByRepository && r.collapsedRepositories[getRepositoryGroup(r.pullRequests[index])]
+}
+
+// findGroupStart returns the index of the first pull request of the group the pull request at index belongs to.
+func (r *PullRequestsScreen) findGroupStart(index int) int {
+	for index > 0 && getRepositoryGroup(r.pullRequests[index-1]) == getRepositoryGroup(r.pullRequests[index]) {
+		index--
+	}
+
+	return index
+}
+
+func (r *PullRequestsScreen) findGroupStarts() []int {
+	var groupStarts []int
+	for i := range r.pullRequests {
+		if r.findGroupStart(i) == i {
+			groupStarts = append(groupStarts, i)
+		}
+	}
+
+	return groupStarts
+}
+
+// navigationStops returns indexes of pull requests that can be selected. Collapsed group can only be selected as a
+// whole, through its first pull request.
+func (r *PullRequestsScreen) navigationStops() []int {
+	var stops []int
+	for i := range r.pullRequests {
+		if r.isCollapsed(i) && r.findGroupStart(i) != i {
+			continue
+		}
+
+		stops = append(stops, i)
+	}
+
+	return stops
+}
+
+// selectedPullRequest returns the selected pull request, or nil if there is none or a collapsed group is selected.
+func (r *PullRequestsScreen) selectedPullRequest() *PullRequest {
+	if len(r.pullRequests) == 0 || r.isCollapsed(r.SelectedPullRequestIndex) {
+		return nil
+	}
+
+	return r.pullRequests[r.SelectedPullRequestIndex]
+}
+
+// countUnresolvedThreads returns the number of review threads of the pull request which have not been resolved yet.
+func countUnresolvedThreads(pullRequest *PullRequestFields) int {
+	unresolved := 0
+	for _, thread := range pullRequest.GetReviewThreads().GetNodes() {
+		if !thread.GetIsResolved() {
+			unresolved++
+		}
+	}
+
+	return unresolved
+}
+
+// findPullRequestFields returns every fetched copy of the pull request. The same pull request may have been fetched
+// both from a watched repository and by discovery.
+func (r *PullRequestsScreen) findPullRequestFields(pullRequestId string) []*PullRequestFields {
+	var found []*PullRequestFields
+	for _, pullRequests := range r.repositoryPullRequests {
+		for _, pullRequest := range pullRequests {
+			if pullRequest.GetId() == pullRequestId {
+				found = append(found, pullRequest)
+			}
+		}
+	}
+	for _, pullRequest := range r.discoveredPullRequests {
+		if pullRequest.GetId() == pullRequestId {
+			found = append(found, pullRequest)
+		}
+	}
+
+	return found
+}
+
+// reviewSubmittedMsg is emitted once a review submitted from the composer has been saved by GitHub.
+type reviewSubmittedMsg struct {
+	pullRequestId string
+	review        *PullRequestReviewFields
+	err           error
+}
+
+// submitReview submits the review together with pending line comments of the pull request, if there are any.
+func (r *PullRequestsScreen) submitReview(pullRequestId string, event PullRequestReviewEvent, body string) tea.Cmd {
+	comments := r.Drafts.GetComments(pullRequestId)
+
+	return func() tea.Msg {
+		r.Logger.Info(fmt.Sprintf("submitting review %v of pull request %v with %v comments", event, pullRequestId, len(comments)))
+
+		var review *PullRequestReviewFields
+		var err error
+		if len(comments) == 0 {
+			review, err = r.GithubApi.AddPullRequestReview(r.GithubApi.Context(), pullRequestId, event, body)
+		} else {
+			review, err = r.GithubApi.SubmitPullRequestReviewWithComments(r.GithubApi.Context(), pullRequestId, event, body, comments)
+		}
+
+		return reviewSubmittedMsg{pullRequestId: pullRequestId, review: review, err: err}
+	}
+}
+
+// applySubmittedReview makes the review the latest review of its author and removes the review request it fulfilled,
+// the same way GitHub does, so that the pull request state can be recomputed without fetching it again.
+func applySubmittedReview(pullRequest *PullRequestFields, review *PullRequestReviewFields) {
+	login := review.GetAuthor().GetLogin()
+
+	if pullRequest.LatestReviews != nil {
+		latestReviews := []*PullRequestReviewFields{review}
+		for _, latestReview := range pullRequest.LatestReviews.Nodes {
+			if latestReview.GetAuthor().GetLogin() != login {
+				latestReviews = append(latestReviews, latestReview)
+			}
+		}
+		pullRequest.LatestReviews.Nodes = latestReviews
+	}
+
+	if pullRequest.ReviewRequests != nil {
+		var reviewRequests []*ReviewRequestFields
+		for _, reviewRequest := range pullRequest.ReviewRequests.Nodes {
+			requestedReviewer, ok := reviewRequest.GetRequestedReviewer().(*ReviewRequestFieldsRequestedReviewerUser)
+			if ok && requestedReviewer.GetLogin() == login {
+				continue
+			}
+			reviewRequests = append(reviewRequests, reviewRequest)
+		}
+		pullRequest.ReviewRequests.Nodes = reviewRequests
+	}
+}
+
+// openComposer starts writing a review of the selected pull request.
+func (r *PullRequestsScreen) openComposer() tea.Cmd {
+	r.state = COMPOSE_REVIEW
+	r.reviewPullRequest = r.selectedPullRequest()
+	r.reviewEventIndex = 0
+	r.reviewErr = nil
+	r.Composer.Reset()
+	r.Composer.SetWidth(r.Window.Width - StyledMain.GetHorizontalPadding())
+	r.Composer.SetHeight(10)
+
+	return r.Composer.Focus()
+}
+
+func (r *PullRequestsScreen) closeComposer() {
+	r.state = DEFAULT
+	r.reviewPullRequest = nil
+	r.reviewErr = nil
+	r.Composer.Reset()
+	r.Composer.Blur()
+}
+
+func (r *PullRequestsScreen) updateComposer(msg tea.KeyMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case helpEscape.Shortcut:
+		{
+			r.closeComposer()
+		}
+	case helpSwitchReviewEvent.Shortcut:
+		{
+			r.reviewEventIndex = (r.reviewEventIndex + 1) % len(REVIEW_EVENTS)
+		}
+	case helpSubmitReview.Shortcut:
+		{
+			event := REVIEW_EVENTS[r.reviewEventIndex]
+			body := strings.TrimSpace(r.Composer.Value())
+
+			// Line comments are enough of a feedback, so a summary comment is only required without them.
+			if body == "" && event != PullRequestReviewEventApprove && len(r.Drafts.GetComments(r.reviewPullRequest.GetId())) == 0 {
+				r.reviewErr = fmt.Errorf("comment is required to %v", strings.ToLower(strings.ReplaceAll(string(event), "_", " ")))
+				break
+			}
+
+			r.state = SUBMIT_REVIEW
+			r.reviewErr = nil
+			cmd = tea.Batch(r.Spinner.Tick, r.submitReview(r.reviewPullRequest.GetId(), event, body))
+		}
+	default:
+		{
+			r.Composer, cmd = r.Composer.Update(msg)
+		}
+	}
+
+	return cmd
+}
+
+func (r *PullRequestsScreen) openSearch() tea.Cmd {
+	r.state = SEARCH
+	r.SearchInput.SetValue(r.filter)
+	r.SearchInput.CursorEnd()
+
+	return r.SearchInput.Focus()
+}
+
+// updateSearch filters pull requests as the query is typed. Shortcuts of the list are not available until the search
+// is closed.
+func (r *PullRequestsScreen) updateSearch(msg tea.KeyMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case helpApplyFilter.Shortcut:
+		{
+			r.state = DEFAULT
+			r.SearchInput.Blur()
+		}
+	case helpClearFilter.Shortcut:
+		{
+			r.state = DEFAULT
+			r.SearchInput.Blur()
+			r.SearchInput.Reset()
+			r.filter = ""
+			r.updatePullRequests()
+		}
+	default:
+		{
+			r.SearchInput, cmd = r.SearchInput.Update(msg)
+			r.filter = r.SearchInput.Value()
+			r.updatePullRequests()
+		}
+	}
+
+	return cmd
+}
+
+func (r *PullRequestsScreen) Init() tea.Cmd {
+	return tea.Batch(r.fetchPullRequests(), r.scheduleRefresh())
+}
+
+func (r *PullRequestsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		{
+			if r.isLoading() || r.state == SUBMIT_REVIEW {
+				r.Spinner, cmd = r.Spinner.Update(msg)
+			}
+		}
+	case tea.WindowSizeMsg:
+		{
+			r.Composer.SetWidth(r.Window.Width - StyledMain.GetHorizontalPadding())
+		}
+	case refreshTickMsg:
+		{
+			if msg.id != r.refreshTickId {
+				break
+			}
+
+			if r.isLoading() {
+				cmd = r.scheduleRefresh()
+			} else {
+				r.Logger.Info("refreshing pull requests in the background")
+				cmd = tea.Batch(r.refreshInBackground(), r.scheduleRefresh())
+			}
+		}
+	case rateLimitResetMsg:
+		{
+			r.isPostponed = false
+			cmd = r.refreshInBackground()
+		}
+	case refreshIntervalUpdatedMsg:
+		{
+			cmd = r.scheduleRefresh()
+		}
+	case githubTokenUpdatedMsg:
+		{
+			cmd = r.fetchPullRequests()
+		}
+	case viewerFetchedMsg:
+		{
+			// Review states and team memberships depend on who I am, so they have to be recomputed once the user
+			// authenticated with the token is known.
+			if msg.err != nil || msg.login == r.fetchLogin {
+				break
+			}
+
+			cmd = r.fetchPullRequests()
+		}
+	case failingChecksSwitchedMsg:
+		{
+			r.updatePullRequests()
+		}
+	case discoveryToggledMsg:
+		{
+			cmd = r.fetchPullRequests()
+			r.updatePullRequests()
+		}
+	case teamsFetchedMsg:
+		{
+			if msg.fetchId != r.fetchId {
+				break
+			}
+
+			if msg.err != nil {
+				r.Logger.Info("could not fetch teams, review requests for teams will not be recognized")
+				r.Logger.Error(msg.err)
+				break
+			}
+
+			r.Logger.Info(fmt.Sprintf("fetched teams %v", strings.Join(msg.teams, ", ")))
+
+			r.teams = map[string]bool{}
+			for _, team := range msg.teams {
+				r.teams[team] = true
+			}
+
+			r.updatePullRequests()
+		}
+	case pullRequestsDiscoveredMsg:
+		{
+			if msg.fetchId != r.fetchId {
+				r.Logger.Info("ignoring outdated discovered pull requests")
+				break
+			}
+
+			if msg.err != nil {
+				r.Logger.Info("could not discover pull requests")
+				r.Logger.Error(msg.err)
+
+				r.discoveryState = REPOSITORY_FAILED
+			} else {
+				r.Logger.Info(fmt.Sprintf("discovered %v pull requests", len(msg.pullRequests)))
+
+				r.discoveryState = REPOSITORY_LOADED
+				r.discoveredPullRequests = msg.pullRequests
+			}
+
+			r.updatePullRequests()
+		}
+	case reviewSubmittedMsg:
+		{
+			if r.state != SUBMIT_REVIEW || msg.pullRequestId != r.reviewPullRequest.GetId() {
+				break
+			}
+
+			if msg.err != nil {
+				r.Logger.Info(fmt.Sprintf("could not submit review of pull request %v", msg.pullRequestId))
+				r.Logger.Error(msg.err)
+
+				r.state = COMPOSE_REVIEW
+				r.reviewErr = msg.err
+				break
+			}
+
+			r.Logger.Info(fmt.Sprintf("submitted review of pull request %v", msg.pullRequestId))
+
+			if err := r.Drafts.ClearComments(msg.pullRequestId); err != nil {
+				cmd = notifyError("could not save drafts", err)
+			}
+
+			for _, pullRequest := range r.findPullRequestFields(msg.pullRequestId) {
+				applySubmittedReview(pullRequest, msg.review)
+			}
+
+			r.closeComposer()
+			r.updatePullRequests()
+		}
+	case reviewThreadsFetchedMsg:
+		{
+			if msg.err != nil {
+				break
+			}
+
+			// Threads fetched by the review threads screen are complete, unlike the first page fetched with the list.
+			for _, pullRequest := range r.findPullRequestFields(msg.pullRequestId) {
+				reviewThreads := &PullRequestFieldsReviewThreadsPullRequestReviewThreadConnection{}
+				for _, thread := range msg.threads {
+					reviewThreads.Nodes = append(reviewThreads.Nodes, &PullRequestFieldsReviewThreadsPullRequestReviewThreadConnectionNodesPullRequestReviewThread{
+						Id:         thread.GetId(),
+						IsResolved: thread.GetIsResolved(),
+					})
+				}
+				pullRequest.ReviewThreads = reviewThreads
+			}
+		}
+	case reviewThreadResolvedMsg:
+		{
+			if msg.err != nil {
+				break
+			}
+
+			for _, pullRequest := range r.findPullRequestFields(msg.pullRequestId) {
+				for _, thread := range pullRequest.GetReviewThreads().GetNodes() {
+					if thread.GetId() == msg.threadId {
+						thread.IsResolved = msg.isResolved
+					}
+				}
+			}
+		}
+	case pullRequestsFetchedMsg:
+		{
+			if msg.fetchId != r.fetchId {
+				r.Logger.Info("ignoring outdated pull requests")
+				break
+			}
+
+			for _, repository := range msg.repositories {
+				if repository.err != nil {
+					r.Logger.Info(fmt.Sprintf("could not fetch pull requests from %v", repository.repositoryUrl))
+					r.Logger.Error(repository.err)
+
+					r.repositoryStates[repository.repositoryUrl] = REPOSITORY_FAILED
+					r.repositoryErrors[repository.repositoryUrl] = repository.err
+
+					// Every repository fails the same way with a rejected token, so the user is told only once.
+					if ClassifyError(repository.err) == ERROR_REASON_UNAUTHORIZED && r.Settings.GithubToken != "" {
+						cmd = tea.Batch(cmd, notify(TOAST_WARNING, "GitHub token was rejected and cleared, paste a new one in the settings screen"))
+						if err := r.Settings.UpdateGitHubToken(""); err != nil {
+							cmd = tea.Batch(cmd, notifyError("could not save settings", err))
+						}
+					}
+				} else {
+					r.Logger.Info(fmt.Sprintf("fetched %v pull requests from %v", len(repository.pullRequests), repository.repositoryUrl))
+
+					r.repositoryStates[repository.repositoryUrl] = REPOSITORY_LOADED
+					r.repositoryPullRequests[repository.repositoryUrl] = repository.pullRequests
+					delete(r.repositoryErrors, repository.repositoryUrl)
+				}
+			}
+
+			r.updatePullRequests()
+
+			repositoryErrors := map[string]error{}
+			for repositoryUrl, err := range r.repositoryErrors {
+				repositoryErrors[repositoryUrl] = err
+			}
+			cmd = tea.Batch(cmd, func() tea.Msg {
+				return repositoryErrorsUpdatedMsg{errors: repositoryErrors}
+			})
+		}
+	case tea.KeyMsg:
+		{
+			if r.state == COMPOSE_REVIEW {
+				cmd = r.updateComposer(msg)
+				break
+			}
+
+			if r.state == SUBMIT_REVIEW {
+				break
+			}
+
+			if r.state == SEARCH {
+				cmd = r.updateSearch(msg)
+				break
+			}
+
+			if index, ok := r.ListViewport.Navigate(msg.String(), r.SelectedPullRequestIndex, len(r.pullRequests)); ok {
+				r.SelectedPullRequestIndex = r.findGroupStart(index)
+				if !r.isCollapsed(index) {
+					r.SelectedPullRequestIndex = index
+				}
+				break
+			}
+
+			switch msg.String() {
+			case helpDown.Shortcut:
+				{
+					stops := r.navigationStops()
+					if len(stops) == 0 {
+						break
+					}
+
+					current := r.SelectedPullRequestIndex
+					r.SelectedPullRequestIndex = stops[0]
+					for _, stop := range stops {
+						if stop > current {
+							r.SelectedPullRequestIndex = stop
+							break
+						}
+					}
+				}
+			case helpUp.Shortcut:
+				{
+					stops := r.navigationStops()
+					if len(stops) == 0 {
+						break
+					}
+
+					current := r.SelectedPullRequestIndex
+					r.SelectedPullRequestIndex = stops[len(stops)-1]
+					for i := len(stops) - 1; i >= 0; i-- {
+						if stops[i] < current {
+							r.SelectedPullRequestIndex = stops[i]
+							break
+						}
+					}
+				}
+			case helpToggleGroupByRepository.Shortcut:
+				{
+					if err := r.Settings.ToggleGroupByRepository(); err != nil {
+						cmd = notifyError("could not save settings", err)
+					}
+					r.updatePullRequests()
+				}
+			case helpToggleRepositoryGroup.Shortcut:
+				{
+					if !r.Settings.GroupByRepository || len(r.pullRequests) == 0 {
+						break
+					}
+
+					repository := getRepositoryGroup(r.pullRequests[r.SelectedPullRequestIndex])
+					r.collapsedRepositories[repository] = !r.collapsedRepositories[repository]
+
+					// Collapsed group is selected through its first pull request, which its header stands for.
+					r.SelectedPullRequestIndex = r.findGroupStart(r.SelectedPullRequestIndex)
+				}
+			case helpNextRepositoryGroup.Shortcut:
+				{
+					if !r.Settings.GroupByRepository || len(r.pullRequests) == 0 {
+						break
+					}
+
+					groupStarts := r.findGroupStarts()
+					current := r.SelectedPullRequestIndex
+					r.SelectedPullRequestIndex = groupStarts[0]
+					for _, groupStart := range groupStarts {
+						if groupStart > current {
+							r.SelectedPullRequestIndex = groupStart
+							break
+						}
+					}
+				}
+			case helpPreviousRepositoryGroup.Shortcut:
+				{
+					if !r.Settings.GroupByRepository || len(r.pullRequests) == 0 {
+						break
+					}
+
+					groupStarts := r.findGroupStarts()
+					current := r.SelectedPullRequestIndex
+					r.SelectedPullRequestIndex = groupStarts[len(groupStarts)-1]
+					for i := len(groupStarts) - 1; i >= 0; i-- {
+						if groupStarts[i] < current {
+							r.SelectedPullRequestIndex = groupStarts[i]
+							break
+						}
+					}
+				}
+			case helpShowPullRequestDetails.Shortcut:
+				{
+					pullRequest := r.selectedPullRequest()
+					if pullRequest == nil {
+						break
+					}
+
+					cmd = openScreen(SCREEN_PULL_REQUEST_DETAILS, pullRequest)
+				}
+			case helpShowDiff.Shortcut:
+				{
+					pullRequest := r.selectedPullRequest()
+					if pullRequest == nil {
+						break
+					}
+
+					cmd = openScreen(SCREEN_DIFF, pullRequest)
+				}
+			case helpReviewPullRequest.Shortcut:
+				{
+					if r.selectedPullRequest() == nil {
+						break
+					}
+
+					cmd = r.openComposer()
+				}
+			case helpShowReviewThreads.Shortcut:
+				{
+					pullRequest := r.selectedPullRequest()
+					if pullRequest == nil {
+						break
+					}
+
+					cmd = openScreen(SCREEN_REVIEW_THREADS, pullRequest)
+				}
+			case helpShowChecks.Shortcut:
+				{
+					pullRequest := r.selectedPullRequest()
+					if pullRequest == nil {
+						break
+					}
+
+					cmd = openScreen(SCREEN_CHECKS, pullRequest)
+				}
+			case helpSwitchSortMode.Shortcut:
+				{
+					if err := r.Settings.SwitchSortMode(); err != nil {
+						cmd = notifyError("could not save settings", err)
+					}
+					r.updatePullRequests()
+				}
+			case helpSearchPullRequests.Shortcut:
+				{
+					cmd = r.openSearch()
+				}
+			case helpClearFilter.Shortcut:
+				{
+					if r.filter == "" {
+						break
+					}
+
+					r.filter = ""
+					r.SearchInput.Reset()
+					r.updatePullRequests()
+				}
+			case helpSwitchPullRequestsTab.Shortcut:
+				{
+					if r.tab == TAB_REVIEW_REQUESTS {
+						r.tab = TAB_MY_PULL_REQUESTS
+					} else {
+						r.tab = TAB_REVIEW_REQUESTS
+					}
+
+					r.SelectedPullRequestIndex = 0
+					r.updatePullRequests()
+				}
+			case helpRefreshPullRequests.Shortcut:
+				{
+					cmd = r.fetchPullRequests()
+				}
+			case helpOpenPullRequest.Shortcut:
+				{
+					selectedPullRequest := r.selectedPullRequest()
+					if selectedPullRequest == nil {
+						break
+					}
+
+					if err := openUrl(selectedPullRequest.GetUrl()); err != nil {
+						cmd = notifyError("could not open pull request in browser", err)
+					}
+				}
+			case helpOpenAllActivePullRequests.Shortcut:
+				{
+					for _, pullRequest := range r.pullRequests {
+						// My own pull requests need my attention only when changes have been requested.
+						isActive := pullRequest.order <= PULL_REQUEST_COMMENTED
+						if r.tab == TAB_MY_PULL_REQUESTS {
+							isActive = pullRequest.order == MY_PULL_REQUEST_CHANGES_REQUESTED
+						}
+
+						if isActive {
+							if err := openUrl(pullRequest.GetUrl()); err != nil {
+								cmd = notifyError("could not open pull requests in browser", err)
+								break
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Composer needs messages other than key presses too, e.g. to blink its cursor.
+	if _, ok := msg.(tea.KeyMsg); !ok && r.state == COMPOSE_REVIEW {
+		var composerCmd tea.Cmd
+		r.Composer, composerCmd = r.Composer.Update(msg)
+		cmd = tea.Batch(cmd, composerCmd)
+	}
+
+	if _, ok := msg.(tea.KeyMsg); !ok && r.state == SEARCH {
+		var searchInputCmd tea.Cmd
+		r.SearchInput, searchInputCmd = r.SearchInput.Update(msg)
+		cmd = tea.Batch(cmd, searchInputCmd)
+	}
+
+	return r, cmd
+}
+
+func (r *PullRequestsScreen) composerView() string {
+	header := StyledHeader.Render(fmt.Sprintf("Review of #%v %v", r.reviewPullRequest.GetNumber(), r.reviewPullRequest.GetTitle()))
+
+	reviewEventToUI := map[PullRequestReviewEvent]string{
+		PullRequestReviewEventApprove:        "Approve",
+		PullRequestReviewEventRequestChanges: "Request changes",
+		PullRequestReviewEventComment:        "Comment",
+	}
+
+	reviewEventToStyle := map[PullRequestReviewEvent]lipgloss.Style{
+		PullRequestReviewEventApprove:        StyledApproved,
+		PullRequestReviewEventRequestChanges: StyledChangesRequested,
+		PullRequestReviewEventComment:        StyledCommented,
+	}
+
+	var events []string
+	for i, event := range REVIEW_EVENTS {
+		if i == r.reviewEventIndex {
+			events = append(events, reviewEventToStyle[event].Render("● "+reviewEventToUI[event]))
+		} else {
+			events = append(events, StyledDraft.Render("○ "+reviewEventToUI[event]))
+		}
+	}
+
+	status := ""
+	if count := len(r.Drafts.GetComments(r.reviewPullRequest.GetId())); count > 0 {
+		status = StyledCommented.Render(fmt.Sprintf("%v pending line comments will be submitted with this review", count)) + "\n"
+	}
+
+	if r.state == SUBMIT_REVIEW {
+		status += fmt.Sprintf("%v Submitting review...", r.Spinner.View())
+	} else if r.reviewErr != nil {
+		status += StyledChangesRequested.Render(fmt.Sprintf("Could not submit review: %v", r.reviewErr))
+	}
+
+	help, err := RenderHelp(REVIEW_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+	if err != nil {
+		r.Logger.Error(err)
+	}
+
+	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, header, strings.Join(events, "   "), "", r.Composer.View(), "", status, help))
+}
+
+// groupHeaderView describes the group starting at the given index with the number of its pull requests in each state.
+func (r *PullRequestsScreen) groupHeaderView(start int) string {
+	stateNames := PULL_REQUEST_STATE_NAMES
+	if r.tab == TAB_MY_PULL_REQUESTS {
+		stateNames = MY_PULL_REQUEST_STATE_NAMES
+	}
+
+	repository := getRepositoryGroup(r.pullRequests[start])
+	counts := map[int]int{}
+	for i := start; i < len(r.pullRequests) && getRepositoryGroup(r.pullRequests[i]) == repository; i++ {
+		counts[r.pullRequests[i].order]++
+	}
+
+	var orders []int
+	for order := range counts {
+		orders = append(orders, order)
+	}
+	sort.Ints(orders)
+
+	var states []string
+	for _, order := range orders {
+		states = append(states, fmt.Sprintf("%v %v", counts[order], stateNames[order]))
+	}
+
+	marker := "▾"
+	if r.collapsedRepositories[repository] {
+		marker = "▸"
+	}
+
+	header := fmt.Sprintf("%v %v (%v)", marker, repository, strings.Join(states, ", "))
+	if r.isCollapsed(start) && start == r.SelectedPullRequestIndex {
+		return StyledUnderline.Render(header)
+	}
+
+	return StyledHelpShortcut.Render(header)
+}
+
+// getColumns returns visible columns of the table. My own pull requests wait for their reviewers, so unless columns
+// were chosen in the settings screen, reviewers are shown on the my pull requests tab as well.
+func (r *PullRequestsScreen) getColumns() []string {
+	if r.tab == TAB_MY_PULL_REQUESTS && len(r.Settings.Columns) == 0 {
+		return append(append([]string{}, DEFAULT_COLUMNS...), COLUMN_REVIEWERS)
+	}
+
+	return r.Settings.GetColumns()
+}
+
+// pullRequestCells renders values of all columns of the pull requests table for the pull request.
+func (r *PullRequestsScreen) pullRequestCells(pullRequest *PullRequest, isSelected bool) map[string]string {
+	cells := map[string]string{
+		COLUMN_NUMBER: fmt.Sprintf("#%v", pullRequest.GetNumber()),
+		COLUMN_TITLE:  pullRequest.GetTitle(),
+		COLUMN_AUTHOR: pullRequest.GetAuthor().GetLogin(),
+		COLUMN_AGE:    formatAge(time.Since(pullRequest.GetCreatedAt())),
+		COLUMN_SIZE:   StyledApproved.Render(fmt.Sprintf("+%v", pullRequest.GetAdditions())) + " " + StyledChangesRequested.Render(fmt.Sprintf("-%v", pullRequest.GetDeletions())),
+	}
+
+	if isSelected {
+		cells[COLUMN_TITLE] = StyledUnderline.Render(pullRequest.GetTitle())
+	}
+
+	// Pull requests from repositories that are not watched are marked with text as well as colour, and the marker is put
+	// in front of the title, so that it is neither truncated nor hidden together with the repository column.
+	cells[COLUMN_REPOSITORY] = pullRequest.GetRepository().GetNameWithOwner()
+	if pullRequest.isFromUnwatchedRepository {
+		cells[COLUMN_REPOSITORY] = StyledUnwatched.Render(pullRequest.GetRepository().GetNameWithOwner())
+		cells[COLUMN_TITLE] = StyledUnwatched.Render("[not watched]") + " " + cells[COLUMN_TITLE]
+	}
+
+	stateNames := PULL_REQUEST_STATE_NAMES
+	stateStyles := map[int]lipgloss.Style{
+		PULL_REQUEST_AWAITING:      StyledAwaiting,
+		PULL_REQUEST_TEAM_AWAITING: StyledTeamAwaiting,
+		PULL_REQUEST_NEW_COMMITS:   StyledNewCommits,
+		PULL_REQUEST_APPROVED:      StyledApproved,
+		PULL_REQUEST_REJECTED:      StyledChangesRequested,
+		PULL_REQUEST_DRAFT:         StyledDraft,
+		PULL_REQUEST_COMMENTED:     StyledCommented,
+	}
+	if r.tab == TAB_MY_PULL_REQUESTS {
+		stateNames = MY_PULL_REQUEST_STATE_NAMES
+		stateStyles = map[int]lipgloss.Style{
+			MY_PULL_REQUEST_CHANGES_REQUESTED: StyledChangesRequested,
+			MY_PULL_REQUEST_REVIEW_REQUIRED:   StyledAwaiting,
+			MY_PULL_REQUEST_APPROVED:          StyledApproved,
+			MY_PULL_REQUEST_DRAFT:             StyledDraft,
+		}
+	}
+
+	stateName, ok := stateNames[pullRequest.order]
+	if !ok {
+		r.Logger.Info(fmt.Sprintf("info does not exist for pull request state %v", pullRequest.order))
+	}
+	cells[COLUMN_STATE] = stateStyles[pullRequest.order].Render(strings.ReplaceAll(stateName, "-", " "))
+
+	switch getChecksState(pullRequest.PullRequestFields) {
+	case StatusStateSuccess:
+		cells[COLUMN_CI] = StyledApproved.Render("✓")
+	case StatusStateFailure, StatusStateError:
+		cells[COLUMN_CI] = StyledChangesRequested.Render("✗")
+	case StatusStatePending, StatusStateExpected:
+		cells[COLUMN_CI] = StyledCommented.Render("●")
+	}
+
+	// Comments are unresolved review threads, followed by line comments that have not been sent yet.
+	var comments []string
+	if count := countUnresolvedThreads(pullRequest.PullRequestFields); count > 0 {
+		comments = append(comments, StyledAwaiting.Render(fmt.Sprintf("%v", count)))
+	}
+	if count := len(r.Drafts.GetComments(pullRequest.GetId())); count > 0 {
+		comments = append(comments, StyledCommented.Render(fmt.Sprintf("✎%v", count)))
+	}
+	cells[COLUMN_COMMENTS] = strings.Join(comments, " ")
+
+	var reviewers []string
+	requested, approved, changesRequested := getReviewers(pullRequest.PullRequestFields)
+	for _, login := range changesRequested {
+		reviewers = append(reviewers, StyledChangesRequested.Render("✗"+login))
+	}
+	for _, login := range approved {
+		reviewers = append(reviewers, StyledApproved.Render("✓"+login))
+	}
+	for _, reviewer := range requested {
+		reviewers = append(reviewers, StyledTeamAwaiting.Render("●"+reviewer))
+	}
+	cells[COLUMN_REVIEWERS] = strings.Join(reviewers, " ")
+
+	return cells
+}
+
+func (r *PullRequestsScreen) tabsView() string {
+	tabToUI := map[string]string{
+		TAB_REVIEW_REQUESTS:  "Review requests",
+		TAB_MY_PULL_REQUESTS: "My pull requests",
+	}
+
+	var tabs []string
+	for _, tab := range []string{TAB_REVIEW_REQUESTS, TAB_MY_PULL_REQUESTS} {
+		if tab == r.tab {
+			tabs = append(tabs, StyledUnderline.Render("● "+tabToUI[tab]))
+		} else {
+			tabs = append(tabs, StyledDraft.Render("○ "+tabToUI[tab]))
+		}
+	}
+
+	return strings.Join(tabs, "   ")
+}
+
+// failedRepositoriesView lists watched repositories that could not be fetched, together with the reason. While
+// loading, failures are already shown next to each repository, so nothing is returned.
+func (r *PullRequestsScreen) failedRepositoriesView(width int) string {
+	if r.isLoading() {
+		return ""
+	}
+
+	var lines []string
+	for _, repositoryUrl := range r.Settings.Repositories {
+		err := r.repositoryErrors[repositoryUrl]
+		if err == nil {
+			continue
+		}
+
+		owner, name := ParseRepositoryUrl(repositoryUrl)
+		lines = append(lines, StyledChangesRequested.Render(fitWidth(fmt.Sprintf("✗ %v/%v could not be fetched: %v", owner, name, ClassifyError(err)), width)))
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// pullRequestsLayout holds parts of the pull requests screen, so that the same rows are laid out in the list viewport
+// while handling messages and rendered by View.
+type pullRequestsLayout struct {
+	header      string
+	tabs        string
+	search      string
+	banner      string
+	message     string
+	help        string
+	titlesRow   string
+	rows        []string
+	selectedRow int
+	width       int
+}
+
+// sections returns everything displayed above the table. Rows of the table are scrolled in the remaining space.
+func (r *pullRequestsLayout) sections() []string {
+	sections := []string{r.header, r.tabs, r.search}
+	if r.banner != "" {
+		sections = append(sections, r.banner)
+	}
+	if r.message != "" {
+		sections = append(sections, r.message)
+	}
+
+	return sections
+}
+
+// listHeight returns how many rows fit in the window. One line is reserved for the scroll indicator.
+func (r *pullRequestsLayout) listHeight(windowHeight int) int {
+	return windowHeight - StyledMain.GetVerticalPadding() - lipgloss.Height(lipgloss.JoinVertical(lipgloss.Left, r.sections()...)) - lipgloss.Height(r.titlesRow) - lipgloss.Height(r.help) - 1
+}
+
+func (r *PullRequestsScreen) layout() pullRequestsLayout {
+	header := StyledHeader.Render(fmt.Sprintf("Pull requests · sorted by %v", r.Settings.GetSortMode()))
+	if r.isLoading() {
+		header = StyledHeader.Render(fmt.Sprintf("%v Pull requests · sorted by %v (%v/%v repositories loaded)", r.Spinner.View(), r.Settings.GetSortMode(), r.countLoadedRepositories(), len(r.repositoryStates)))
+	}
+
+	var pullRequestMessage string
+	if r.isLoading() {
+		for _, repositoryUrl := range r.Settings.Repositories {
+			switch r.repositoryStates[repositoryUrl] {
+			case REPOSITORY_LOADING:
+				pullRequestMessage += fmt.Sprintf("%v %v\n", r.Spinner.View(), repositoryUrl)
+			case REPOSITORY_LOADED:
+				pullRequestMessage += fmt.Sprintf("%v %v\n", StyledApproved.Render("✓"), repositoryUrl)
+			case REPOSITORY_FAILED:
+				pullRequestMessage += fmt.Sprintf("%v %v\n", StyledChangesRequested.Render("✗"), repositoryUrl)
+			}
+		}
+
+		switch r.discoveryState {
+		case REPOSITORY_LOADING:
+			pullRequestMessage += fmt.Sprintf("%v %v\n", r.Spinner.View(), DISCOVERY_LABEL)
+		case REPOSITORY_LOADED:
+			pullRequestMessage += fmt.Sprintf("%v %v\n", StyledApproved.Render("✓"), DISCOVERY_LABEL)
+		case REPOSITORY_FAILED:
+			pullRequestMessage += fmt.Sprintf("%v %v\n", StyledChangesRequested.Render("✗"), DISCOVERY_LABEL)
+		}
+		pullRequestMessage += "\n"
+	}
+
+	if len(r.pullRequests) == 0 {
+		if r.filter != "" {
+			pullRequestMessage += "No pull requests match the filter.\n"
+		} else if !r.isLoading() && r.tab == TAB_MY_PULL_REQUESTS {
+			pullRequestMessage = "You do not have any open pull requests.\n"
+		} else if !r.isLoading() {
+			pullRequestMessage = "You do not have any pull requests yet.\n"
+		}
+	}
+
+	width := r.Window.Width - StyledMain.GetHorizontalPadding()
+
+	// Rows are prefixed with a marker of the selected row, and indented under group headers.
+	indentation := "  "
+	if r.Settings.GroupByRepository {
+		indentation = "    "
+	}
+
+	columns := r.getColumns()
+	widths := getColumnWidths(columns, width-len(indentation))
+
+	var titles []string
+	for _, column := range columns {
+		titles = append(titles, COLUMN_DEFINITIONS[column].Title)
+	}
+	titlesRow := StyledHelpDescription.Render(indentation + renderTableRow(titles, widths, width-len(indentation)))
+
+	var rows []string
+	selectedRow := 0
+	for i, pullRequest := range r.pullRequests {
+		if r.Settings.GroupByRepository && r.findGroupStart(i) == i {
+			if i == r.SelectedPullRequestIndex {
+				selectedRow = len(rows)
+			}
+			rows = append(rows, fitWidth(r.groupHeaderView(i), width))
+		}
+
+		if r.isCollapsed(i) {
+			continue
+		}
+
+		isSelected := i == r.SelectedPullRequestIndex
+		cells := r.pullRequestCells(pullRequest, isSelected)
+
+		var row []string
+		for _, column := range columns {
+			row = append(row, cells[column])
+		}
+
+		prefix := indentation
+		if isSelected {
+			prefix = indentation[2:] + StyledSpinner.Render("▌ ")
+			selectedRow = len(rows)
+		}
+
+		rows = append(rows, prefix+renderTableRow(row, widths, width-len(indentation)))
+	}
+
+	pullRequestsWrapper := wordwrap.NewWriter(r.Window.Width - StyledMain.GetHorizontalPadding())
+	pullRequestsWrapper.Breakpoints = []rune{' '}
+	_, err := pullRequestsWrapper.Write([]byte(pullRequestMessage))
+	if err != nil {
+		r.Logger.Error(err)
+	}
+
+	helps := PULL_REQUESTS_HELP
+	if r.Settings.GroupByRepository {
+		helps = append(append([]Help{}, PULL_REQUESTS_HELP...), GROUP_HELP...)
+	}
+
+	search := ""
+	if r.state == SEARCH {
+		helps = SEARCH_HELP
+		search = r.SearchInput.View() + "\n"
+	} else if r.filter != "" {
+		search = StyledHelpDescription.Render(fmt.Sprintf("Filtered by: %v", r.filter)) + "\n"
+	}
+
+	help, err := RenderHelp(helps, r.Window.Width-StyledMain.GetHorizontalPadding())
+	if err != nil {
+		r.Logger.Error(err)
+	}
+
+	return pullRequestsLayout{
+		header:      header,
+		tabs:        r.tabsView(),
+		search:      search,
+		banner:      r.failedRepositoriesView(width),
+		message:     pullRequestsWrapper.String(),
+		help:        help,
+		titlesRow:   titlesRow,
+		rows:        rows,
+		selectedRow: selectedRow,
+		width:       width,
+	}
+}
+
+// layoutList scrolls the table to the selected pull request. The router calls it after messages handled while the
+// screen is displayed, because any of them may change rows of the table or the space they have.
+func (r *PullRequestsScreen) layoutList() {
+	if r.state == COMPOSE_REVIEW || r.state == SUBMIT_REVIEW {
+		return
+	}
+
+	layout := r.layout()
+	r.ListViewport.Layout(len(layout.rows), layout.selectedRow, layout.listHeight(r.Window.Height))
+}
+
+func (r *PullRequestsScreen) View() string {
+	if r.state == COMPOSE_REVIEW || r.state == SUBMIT_REVIEW {
+		return r.composerView()
+	}
+
+	layout := r.layout()
+
+	if len(layout.rows) == 0 {
+		return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, layout.header, layout.tabs, layout.search, layout.banner, layout.message, layout.help))
+	}
+
+	sections := layout.sections()
+	list := r.ListViewport.View(layout.rows, layout.selectedRow, layout.width, layout.listHeight(r.Window.Height))
+	sections = append(sections, layout.titlesRow, list, layout.help)
 
-	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, header, pullRequestsWrapper.String(), helpWrapper.String()))
+	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
 }
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type pullRequestTestFields struct {
+	id         string
+	repository string
+	author     string
+	createdAt  time.Time
+	updatedAt  time.Time
+	additions  int
+	deletions  int
+}
+
+func newTestPullRequest(fields pullRequestTestFields) *PullRequest {
+	return &PullRequest{
+		PullRequestFields: &PullRequestFields{
+			Id:         fields.id,
+			Repository: &PullRequestFieldsRepository{NameWithOwner: fields.repository},
+			Author:     &PullRequestFieldsAuthorUser{Login: fields.author},
+			CreatedAt:  fields.createdAt,
+			UpdatedAt:  fields.updatedAt,
+			Additions:  fields.additions,
+			Deletions:  fields.deletions,
+		},
+	}
+}
+
+func sign(number int) int {
+	switch {
+	case number < 0:
+		return -1
+	case number > 0:
+		return 1
+	}
+
+	return 0
+}
+
+func TestComparePullRequests(t *testing.T) {
+	earlier := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		a        pullRequestTestFields
+		b        pullRequestTestFields
+		sortMode string
+		want     int
+	}{
+		{
+			name:     "state does not tell pull requests apart",
+			a:        pullRequestTestFields{createdAt: earlier, additions: 1},
+			b:        pullRequestTestFields{createdAt: later, additions: 100},
+			sortMode: SORT_MODE_STATE,
+			want:     0,
+		},
+		{
+			name:     "oldest goes first",
+			a:        pullRequestTestFields{createdAt: earlier},
+			b:        pullRequestTestFields{createdAt: later},
+			sortMode: SORT_MODE_AGE,
+			want:     -1,
+		},
+		{
+			name:     "same age",
+			a:        pullRequestTestFields{createdAt: earlier},
+			b:        pullRequestTestFields{createdAt: earlier},
+			sortMode: SORT_MODE_AGE,
+			want:     0,
+		},
+		{
+			name:     "most recently updated goes first",
+			a:        pullRequestTestFields{updatedAt: earlier},
+			b:        pullRequestTestFields{updatedAt: later},
+			sortMode: SORT_MODE_UPDATED,
+			want:     1,
+		},
+		{
+			name:     "repositories are compared ignoring case",
+			a:        pullRequestTestFields{repository: "Acme/web"},
+			b:        pullRequestTestFields{repository: "acme/api"},
+			sortMode: SORT_MODE_REPOSITORY,
+			want:     1,
+		},
+		{
+			name:     "authors are compared ignoring case",
+			a:        pullRequestTestFields{author: "alice"},
+			b:        pullRequestTestFields{author: "Bob"},
+			sortMode: SORT_MODE_AUTHOR,
+			want:     -1,
+		},
+		{
+			name:     "smallest goes first",
+			a:        pullRequestTestFields{additions: 10, deletions: 10},
+			b:        pullRequestTestFields{additions: 5, deletions: 1},
+			sortMode: SORT_MODE_SIZE,
+			want:     1,
+		},
+		{
+			name:     "unknown sort mode",
+			a:        pullRequestTestFields{createdAt: earlier},
+			b:        pullRequestTestFields{createdAt: later},
+			sortMode: "unknown",
+			want:     0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := sign(comparePullRequests(newTestPullRequest(test.a), newTestPullRequest(test.b), test.sortMode))
+
+			if got != test.want {
+				t.Errorf("comparePullRequests() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGroupPullRequestsByRepository(t *testing.T) {
+	tests := []struct {
+		name         string
+		repositories []string
+		want         []string
+	}{
+		{
+			name: "no pull requests",
+		},
+		{
+			name:         "single repository",
+			repositories: []string{"acme/api", "acme/api"},
+			want:         []string{"0", "1"},
+		},
+		{
+			name:         "groups are ordered by their first pull request",
+			repositories: []string{"acme/web", "acme/api", "acme/web", "acme/cli", "acme/api"},
+			want:         []string{"0", "2", "1", "4", "3"},
+		},
+		{
+			name:         "already grouped",
+			repositories: []string{"acme/web", "acme/web", "acme/api"},
+			want:         []string{"0", "1", "2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var pullRequests []*PullRequest
+			for i, repository := range test.repositories {
+				pullRequests = append(pullRequests, newTestPullRequest(pullRequestTestFields{id: strconv.Itoa(i), repository: repository}))
+			}
+
+			var got []string
+			for _, pullRequest := range groupPullRequestsByRepository(pullRequests) {
+				got = append(got, pullRequest.GetId())
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("groupPullRequestsByRepository() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
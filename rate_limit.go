@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Khan/genqlient/graphql"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RATE_LIMIT_RESERVE is the part of the GraphQL budget kept for actions taken by the user. Background refreshes are
+// postponed until the budget resets once less than that remains.
+const RATE_LIMIT_RESERVE = 100
+
+// SECONDARY_RATE_LIMIT_BACK_OFF is used when GitHub reports a secondary rate limit without saying how long to wait.
+const SECONDARY_RATE_LIMIT_BACK_OFF = time.Minute
+
+// RateLimiter keeps the latest rate limit reported by GitHub and decides when requests have to wait.
+type RateLimiter struct {
+	mutex     sync.Mutex
+	rateLimit *RateLimitFields
+	// backOffUntil is set when GitHub rejects requests because a primary or secondary rate limit was exceeded.
+	backOffUntil time.Time
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{}
+}
+
+// Update records the rate limit returned with a response. Responses without it are ignored.
+func (r *RateLimiter) Update(rateLimit *RateLimitFields) {
+	if rateLimit == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	copied := *rateLimit
+	r.rateLimit = &copied
+}
+
+// BackOff stops requests from being sent until the given time.
+func (r *RateLimiter) BackOff(until time.Time) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if until.After(r.backOffUntil) {
+		r.backOffUntil = until
+	}
+}
+
+// Reset forgets everything known about the budget, e.g. after the token has changed.
+func (r *RateLimiter) Reset() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.rateLimit = nil
+	r.backOffUntil = time.Time{}
+}
+
+// GetRateLimit returns a copy of the latest rate limit, or nil if none was received yet.
+func (r *RateLimiter) GetRateLimit() *RateLimitFields {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.rateLimit == nil {
+		return nil
+	}
+
+	copied := *r.rateLimit
+	return &copied
+}
+
+// GetBackOffUntil returns the time until which requests are not sent, or zero time if they are not held back.
+func (r *RateLimiter) GetBackOffUntil(now time.Time) time.Time {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.backOffUntil.After(now) {
+		return r.backOffUntil
+	}
+
+	return time.Time{}
+}
+
+// PostponedUntil returns the time until which background refreshes should wait, or zero time if they can run now.
+func (r *RateLimiter) PostponedUntil(now time.Time) time.Time {
+	if backOffUntil := r.GetBackOffUntil(now); !backOffUntil.IsZero() {
+		return backOffUntil
+	}
+
+	rateLimit := r.GetRateLimit()
+	if rateLimit != nil && rateLimit.GetRemaining() < RATE_LIMIT_RESERVE && rateLimit.GetResetAt().After(now) {
+		return rateLimit.GetResetAt()
+	}
+
+	return time.Time{}
+}
+
+var errRequestsPaused = errors.New("requests are paused because of rate limit")
+
+// RateLimitTransport refuses to send requests while the rate limiter backs off, and starts backing off when GitHub
+// responds that a rate limit was exceeded.
+type RateLimitTransport struct {
+	rateLimiter  *RateLimiter
+	roundTripper http.RoundTripper
+}
+
+func (r *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if until := r.rateLimiter.GetBackOffUntil(time.Now()); !until.IsZero() {
+		return nil, fmt.Errorf("%w until %v", errRequestsPaused, until.Local().Format(time.Kitchen))
+	}
+
+	res, err := r.roundTripper.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if until, exceeded := rateLimitExceededUntil(res); exceeded {
+		r.rateLimiter.BackOff(until)
+	}
+
+	return res, nil
+}
+
+// rateLimitExceededUntil recognizes responses rejected because of a rate limit and returns when requests can be sent
+// again, as advised by GitHub in the Retry-After or X-RateLimit-Reset headers.
+func rateLimitExceededUntil(res *http.Response) (time.Time, bool) {
+	if res.StatusCode != http.StatusForbidden && res.StatusCode != http.StatusTooManyRequests {
+		return time.Time{}, false
+	}
+
+	if retryAfter, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil {
+		return time.Now().Add(time.Duration(retryAfter) * time.Second), true
+	}
+
+	if res.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return time.Unix(reset, 0), true
+		}
+	}
+
+	// Secondary rate limits are not always accompanied by headers, so the message is checked as well. The body is
+	// restored afterwards, so that callers can still read the error.
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if err == nil && strings.Contains(strings.ToLower(string(body)), "secondary rate limit") {
+		return time.Now().Add(SECONDARY_RATE_LIMIT_BACK_OFF), true
+	}
+
+	return time.Time{}, false
+}
+
+// rateLimitedResponse is implemented by responses of all queries, because each of them requests the rate limit.
+type rateLimitedResponse interface {
+	GetRateLimit() *RateLimitFields
+}
+
+// RateLimitRecordingClient passes rate limits returned with responses to the rate limiter.
+type RateLimitRecordingClient struct {
+	client      graphql.Client
+	rateLimiter *RateLimiter
+}
+
+func (r *RateLimitRecordingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	err := r.client.MakeRequest(ctx, req, resp)
+
+	if data, ok := resp.Data.(rateLimitedResponse); ok {
+		r.rateLimiter.Update(data.GetRateLimit())
+	}
+
+	return err
+}
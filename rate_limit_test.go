@@ -0,0 +1,91 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimitExceededUntil(t *testing.T) {
+	reset := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		headers    map[string]string
+		body       string
+		exceeded   bool
+		// wait is how long requests have to wait, or zero if the time is given by reset.
+		wait time.Duration
+	}{
+		{
+			name:       "successful response",
+			statusCode: http.StatusOK,
+			headers:    map[string]string{"Retry-After": "60"},
+		},
+		{
+			name:       "retry after",
+			statusCode: http.StatusTooManyRequests,
+			headers:    map[string]string{"Retry-After": "60"},
+			exceeded:   true,
+			wait:       time.Minute,
+		},
+		{
+			name:       "primary rate limit",
+			statusCode: http.StatusForbidden,
+			headers:    map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(reset.Unix(), 10)},
+			exceeded:   true,
+		},
+		{
+			name:       "secondary rate limit without headers",
+			statusCode: http.StatusForbidden,
+			body:       `{"message": "You have exceeded a Secondary Rate Limit."}`,
+			exceeded:   true,
+			wait:       SECONDARY_RATE_LIMIT_BACK_OFF,
+		},
+		{
+			name:       "forbidden for another reason",
+			statusCode: http.StatusForbidden,
+			headers:    map[string]string{"X-RateLimit-Remaining": "4000"},
+			body:       `{"message": "Resource not accessible by integration"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := &http.Response{
+				StatusCode: test.statusCode,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(test.body)),
+			}
+			for key, value := range test.headers {
+				res.Header.Set(key, value)
+			}
+
+			until, exceeded := rateLimitExceededUntil(res)
+
+			if exceeded != test.exceeded {
+				t.Fatalf("exceeded = %v, want %v", exceeded, test.exceeded)
+			}
+
+			want := time.Time{}
+			if test.exceeded {
+				want = reset
+				if test.wait != 0 {
+					want = time.Now().Add(test.wait)
+				}
+			}
+			if until.Sub(want).Abs() > time.Second {
+				t.Errorf("until = %v, want %v", until, want)
+			}
+
+			// The body has to stay readable for callers.
+			if body, err := io.ReadAll(res.Body); err != nil || string(body) != test.body {
+				t.Errorf("body = %q, %v, want %q", body, err, test.body)
+			}
+		})
+	}
+}
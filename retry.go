@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Khan/genqlient/graphql"
+	"io"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RETRY_BASE_DELAY is the delay before the first retry. Every following retry waits twice as long, up to
+// RETRY_MAX_DELAY.
+const RETRY_BASE_DELAY = 500 * time.Millisecond
+const RETRY_MAX_DELAY = 10 * time.Second
+
+// retryableRequestKey marks contexts of requests that are safe to send more than once.
+type retryableRequestKey struct{}
+
+// RetryTransport limits how long a single attempt of a request can take, and retries requests that failed because of
+// network errors, timeouts or server errors. Only GET requests and requests marked as retryable are retried, because a
+// request that failed on the way back might have been applied anyway.
+type RetryTransport struct {
+	timeout      time.Duration
+	maxRetries   int
+	roundTripper http.RoundTripper
+	*Logger
+}
+
+func (r *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	maxRetries := 0
+	if req.Method == http.MethodGet || req.Context().Value(retryableRequestKey{}) == true {
+		maxRetries = r.maxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := r.roundTripOnce(req)
+
+		// Requests held back by the rate limiter would only be held back again.
+		retryable := err == nil && res.StatusCode >= http.StatusInternalServerError || err != nil && !errors.Is(err, errRequestsPaused)
+		replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+		if !retryable || !replayable || attempt >= maxRetries || req.Context().Err() != nil {
+			return res, err
+		}
+
+		if err != nil {
+			r.Logger.Info(fmt.Sprintf("retrying request to %v after error: %v", req.URL, err))
+		} else {
+			r.Logger.Info(fmt.Sprintf("retrying request to %v after status %v", req.URL, res.Status))
+			res.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(retryDelay(attempt)):
+		}
+	}
+}
+
+// roundTripOnce sends a single attempt of the request, which is canceled once the timeout passes. The timeout covers
+// reading the body as well, so it is only released when the body is closed.
+func (r *RetryTransport) roundTripOnce(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), r.timeout)
+
+	attempt := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		attempt.Body = body
+	}
+
+	res, err := r.roundTripper.RoundTrip(attempt)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+
+	return res, nil
+}
+
+// retryDelay doubles the delay with every attempt. Delays are jittered, so that requests that failed at the same time
+// are not retried at the same time too.
+func retryDelay(attempt int) time.Duration {
+	delay := RETRY_BASE_DELAY << attempt
+	if delay > RETRY_MAX_DELAY || delay <= 0 {
+		delay = RETRY_MAX_DELAY
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *cancelOnCloseBody) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
+
+// RetryableQueriesClient marks queries as retryable. Mutations are never retried, so that e.g. a review is not
+// submitted twice.
+type RetryableQueriesClient struct {
+	client graphql.Client
+}
+
+func (r *RetryableQueriesClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if !strings.HasPrefix(strings.TrimSpace(req.Query), "mutation") {
+		ctx = context.WithValue(ctx, retryableRequestKey{}, true)
+	}
+
+	return r.client.MakeRequest(ctx, req, resp)
+}
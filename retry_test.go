@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempt int
+		// delay is the delay before jitter, the actual one is between its half and itself.
+		delay time.Duration
+	}{
+		{attempt: 0, delay: RETRY_BASE_DELAY},
+		{attempt: 1, delay: 2 * RETRY_BASE_DELAY},
+		{attempt: 3, delay: 8 * RETRY_BASE_DELAY},
+		{attempt: 5, delay: RETRY_MAX_DELAY},
+		// Shifting by that much overflows, which must not result in a negative delay.
+		{attempt: 64, delay: RETRY_MAX_DELAY},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("attempt %v", test.attempt), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				if delay := retryDelay(test.attempt); delay < test.delay/2 || delay > test.delay {
+					t.Fatalf("retryDelay(%v) = %v, want between %v and %v", test.attempt, delay, test.delay/2, test.delay)
+				}
+			}
+		})
+	}
+}
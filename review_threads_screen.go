@@ -0,0 +1,477 @@
+package main
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+	"strings"
+)
+
+var REVIEW_THREADS_HELP = []Help{helpUp, helpDown, helpToggleThreadResolved, helpReplyToThread, helpBack, helpOpenPullRequest}
+
+var REPLY_HELP = []Help{helpSendReply, helpEscape}
+
+const COMPOSE_REPLY string = "COMPOSE_REPLY"
+
+type ReviewThreadsScreen struct {
+	*Window
+	*Settings
+	*Logger
+	*GithubApi
+	Viewport            viewport.Model
+	Spinner             spinner.Model
+	Composer            textarea.Model
+	state               string
+	pullRequest         *PullRequest
+	threads             []*ReviewThreadFields
+	threadOffsets       []int
+	SelectedThreadIndex int
+	// pending is set while a thread is being resolved, unresolved or replied to.
+	pending bool
+	err     error
+}
+
+func NewReviewThreadsScreen(globalState *Window, settings *Settings, logger *Logger, githubApi *GithubApi) *ReviewThreadsScreen {
+	composer := textarea.New()
+	composer.Placeholder = "Leave a reply..."
+	composer.ShowLineNumbers = false
+	composer.CharLimit = 0
+
+	return &ReviewThreadsScreen{
+		Window:    globalState,
+		Settings:  settings,
+		Logger:    logger,
+		GithubApi: githubApi,
+		Viewport:  viewport.New(0, 0),
+		Spinner:   spinner.New(spinner.WithSpinner(spinner.Dot), spinner.WithStyle(StyledSpinner)),
+		Composer:  composer,
+		state:     DEFAULT,
+	}
+}
+
+// reviewThreadsFetchedMsg is emitted once review threads of a pull request have been fetched.
+type reviewThreadsFetchedMsg struct {
+	pullRequestId string
+	threads       []*ReviewThreadFields
+	err           error
+}
+
+// reviewThreadResolvedMsg is emitted once a review thread has been resolved or unresolved.
+type reviewThreadResolvedMsg struct {
+	pullRequestId string
+	threadId      string
+	isResolved    bool
+	err           error
+}
+
+// reviewThreadRepliedMsg is emitted once a reply has been added to a review thread.
+type reviewThreadRepliedMsg struct {
+	pullRequestId string
+	threadId      string
+	comment       *ReviewCommentFields
+	err           error
+}
+
+func (r *ReviewThreadsScreen) fetchThreads(pullRequest *PullRequest) tea.Cmd {
+	id := pullRequest.GetId()
+	owner, name := ParseRepositoryUrl(pullRequest.GetRepository().GetUrl())
+	number := pullRequest.GetNumber()
+	maxPages := r.Settings.GetMaxPages()
+
+	return func() tea.Msg {
+		r.Logger.Info(fmt.Sprintf("fetching review threads of pull request %v/%v#%v", owner, name, number))
+
+		threads, err := r.GithubApi.GetPullRequestReviewThreads(r.GithubApi.Context(), owner, name, number, maxPages)
+
+		return reviewThreadsFetchedMsg{pullRequestId: id, threads: threads, err: err}
+	}
+}
+
+func (r *ReviewThreadsScreen) setThreadResolved(thread *ReviewThreadFields, resolved bool) tea.Cmd {
+	pullRequestId := r.pullRequest.GetId()
+	threadId := thread.GetId()
+
+	return func() tea.Msg {
+		r.Logger.Info(fmt.Sprintf("setting resolved state of review thread %v to %v", threadId, resolved))
+
+		isResolved, err := r.GithubApi.SetReviewThreadResolved(r.GithubApi.Context(), threadId, resolved)
+
+		return reviewThreadResolvedMsg{pullRequestId: pullRequestId, threadId: threadId, isResolved: isResolved, err: err}
+	}
+}
+
+func (r *ReviewThreadsScreen) reply(thread *ReviewThreadFields, body string) tea.Cmd {
+	pullRequestId := r.pullRequest.GetId()
+	threadId := thread.GetId()
+	comments := thread.GetComments().GetNodes()
+	commentId := comments[len(comments)-1].GetId()
+
+	return func() tea.Msg {
+		r.Logger.Info(fmt.Sprintf("replying to review thread %v", threadId))
+
+		comment, err := r.GithubApi.ReplyToReviewComment(r.GithubApi.Context(), pullRequestId, commentId, body)
+
+		return reviewThreadRepliedMsg{pullRequestId: pullRequestId, threadId: threadId, comment: comment, err: err}
+	}
+}
+
+// Open shows review threads of the pull request, fetching them in the background.
+func (r *ReviewThreadsScreen) Open(pullRequest *PullRequest) tea.Cmd {
+	r.pullRequest = pullRequest
+	r.threads = nil
+	r.threadOffsets = nil
+	r.SelectedThreadIndex = 0
+	r.pending = false
+	r.err = nil
+	r.closeComposer()
+	r.resize()
+	r.Viewport.SetContent("")
+	r.Viewport.GotoTop()
+
+	return tea.Batch(r.Spinner.Tick, r.fetchThreads(pullRequest))
+}
+
+func (r *ReviewThreadsScreen) resize() {
+	help, _ := RenderHelp(REVIEW_THREADS_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+
+	r.Viewport.Width = r.Window.Width - StyledMain.GetHorizontalPadding()
+	// One line is reserved for the status of pending actions.
+	r.Viewport.Height = r.Window.Height - StyledMain.GetVerticalPadding() - lipgloss.Height(StyledHeader.Render("")) - lipgloss.Height(help) - 1
+	if r.Viewport.Height < 1 {
+		r.Viewport.Height = 1
+	}
+
+	r.Composer.SetWidth(r.Viewport.Width)
+}
+
+func (r *ReviewThreadsScreen) findThread(threadId string) *ReviewThreadFields {
+	for _, thread := range r.threads {
+		if thread.GetId() == threadId {
+			return thread
+		}
+	}
+
+	return nil
+}
+
+// render lays out threads in the viewport, keeping track of lines at which threads start.
+func (r *ReviewThreadsScreen) render() {
+	if r.threads == nil {
+		return
+	}
+
+	if len(r.threads) == 0 {
+		r.Viewport.SetContent(StyledDraft.Render("This pull request does not have any review threads."))
+		return
+	}
+
+	r.threadOffsets = nil
+	var lines []string
+	for i, thread := range r.threads {
+		r.threadOffsets = append(r.threadOffsets, len(lines))
+
+		state := StyledAwaiting.Render("unresolved")
+		if thread.GetIsResolved() {
+			state = StyledApproved.Render("resolved")
+		}
+		if thread.GetIsOutdated() {
+			state += " " + StyledDraft.Render("outdated")
+		}
+
+		line := thread.GetLine()
+		if line == 0 {
+			line = thread.GetOriginalLine()
+		}
+
+		location := fmt.Sprintf("%v:%v", thread.GetPath(), line)
+		if i == r.SelectedThreadIndex {
+			lines = append(lines, StyledSpinner.Render("▌ ")+StyledUnderline.Render(location)+" "+state)
+		} else {
+			lines = append(lines, "  "+location+" "+state)
+		}
+
+		for _, comment := range thread.GetComments().GetNodes() {
+			lines = append(lines, fmt.Sprintf("    %v %v", comment.GetAuthor().GetLogin(), StyledDraft.Render(comment.GetCreatedAt().Local().Format("2006-01-02 15:04"))))
+
+			body := wordwrap.String(strings.TrimSpace(comment.GetBody()), r.Viewport.Width-6)
+			for _, bodyLine := range strings.Split(body, "\n") {
+				lines = append(lines, "      "+bodyLine)
+			}
+		}
+
+		lines = append(lines, "")
+	}
+
+	r.Viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+// selectThread selects the thread and scrolls the viewport so that its beginning is visible.
+func (r *ReviewThreadsScreen) selectThread(index int) {
+	if len(r.threads) == 0 {
+		return
+	}
+
+	r.SelectedThreadIndex = (index + len(r.threads)) % len(r.threads)
+	r.render()
+
+	offset := r.threadOffsets[r.SelectedThreadIndex]
+	if offset < r.Viewport.YOffset || offset >= r.Viewport.YOffset+r.Viewport.Height {
+		r.Viewport.SetYOffset(offset)
+	}
+}
+
+func (r *ReviewThreadsScreen) selectedThread() *ReviewThreadFields {
+	if r.SelectedThreadIndex >= len(r.threads) {
+		return nil
+	}
+
+	return r.threads[r.SelectedThreadIndex]
+}
+
+func (r *ReviewThreadsScreen) openComposer() tea.Cmd {
+	r.state = COMPOSE_REPLY
+	r.Composer.Reset()
+	r.Composer.SetHeight(10)
+
+	return r.Composer.Focus()
+}
+
+func (r *ReviewThreadsScreen) closeComposer() {
+	r.state = DEFAULT
+	r.Composer.Reset()
+	r.Composer.Blur()
+}
+
+func (r *ReviewThreadsScreen) updateComposer(msg tea.KeyMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case helpEscape.Shortcut:
+		{
+			r.closeComposer()
+		}
+	case helpSendReply.Shortcut:
+		{
+			body := strings.TrimSpace(r.Composer.Value())
+			thread := r.selectedThread()
+			if body == "" || thread == nil {
+				break
+			}
+
+			r.pending = true
+			r.err = nil
+			cmd = tea.Batch(r.Spinner.Tick, r.reply(thread, body))
+			r.closeComposer()
+		}
+	default:
+		{
+			r.Composer, cmd = r.Composer.Update(msg)
+		}
+	}
+
+	return cmd
+}
+
+func (r *ReviewThreadsScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (r *ReviewThreadsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		{
+			if r.pullRequest != nil && (r.threads == nil && r.err == nil || r.pending) {
+				r.Spinner, cmd = r.Spinner.Update(msg)
+			}
+		}
+	case tea.WindowSizeMsg:
+		{
+			r.resize()
+			r.render()
+		}
+	case reviewThreadsFetchedMsg:
+		{
+			if r.pullRequest == nil || msg.pullRequestId != r.pullRequest.GetId() {
+				break
+			}
+
+			if msg.err != nil {
+				r.Logger.Info(fmt.Sprintf("could not fetch review threads of pull request %v", msg.pullRequestId))
+				r.Logger.Error(msg.err)
+
+				r.err = msg.err
+				break
+			}
+
+			r.threads = msg.threads
+			if r.threads == nil {
+				r.threads = []*ReviewThreadFields{}
+			}
+			r.render()
+		}
+	case reviewThreadResolvedMsg:
+		{
+			if r.pullRequest == nil || msg.pullRequestId != r.pullRequest.GetId() {
+				break
+			}
+
+			r.pending = false
+			if msg.err != nil {
+				r.Logger.Info(fmt.Sprintf("could not change resolved state of review thread %v", msg.threadId))
+				r.Logger.Error(msg.err)
+
+				r.err = msg.err
+				break
+			}
+
+			if thread := r.findThread(msg.threadId); thread != nil {
+				thread.IsResolved = msg.isResolved
+			}
+			r.render()
+		}
+	case reviewThreadRepliedMsg:
+		{
+			if r.pullRequest == nil || msg.pullRequestId != r.pullRequest.GetId() {
+				break
+			}
+
+			r.pending = false
+			if msg.err != nil {
+				r.Logger.Info(fmt.Sprintf("could not reply to review thread %v", msg.threadId))
+				r.Logger.Error(msg.err)
+
+				r.err = msg.err
+				break
+			}
+
+			if thread := r.findThread(msg.threadId); thread != nil && thread.Comments != nil {
+				thread.Comments.Nodes = append(thread.Comments.Nodes, msg.comment)
+			}
+			r.render()
+		}
+	case tea.KeyMsg:
+		{
+			if r.state == COMPOSE_REPLY {
+				cmd = r.updateComposer(msg)
+				break
+			}
+
+			switch msg.String() {
+			case helpBack.Shortcut:
+				{
+					return r, openScreen(SCREEN_PULL_REQUESTS, nil)
+				}
+			case helpOpenPullRequest.Shortcut:
+				{
+					if r.pullRequest == nil {
+						break
+					}
+
+					if err := openUrl(r.pullRequest.GetUrl()); err != nil {
+						cmd = notifyError("could not open pull request in browser", err)
+					}
+				}
+			case helpDown.Shortcut:
+				{
+					r.selectThread(r.SelectedThreadIndex + 1)
+				}
+			case helpUp.Shortcut:
+				{
+					r.selectThread(r.SelectedThreadIndex - 1)
+				}
+			case helpToggleThreadResolved.Shortcut:
+				{
+					thread := r.selectedThread()
+					if thread == nil || r.pending {
+						break
+					}
+
+					r.pending = true
+					r.err = nil
+					cmd = tea.Batch(r.Spinner.Tick, r.setThreadResolved(thread, !thread.GetIsResolved()))
+				}
+			case helpReplyToThread.Shortcut:
+				{
+					thread := r.selectedThread()
+					if thread == nil || r.pending || len(thread.GetComments().GetNodes()) == 0 {
+						break
+					}
+
+					cmd = r.openComposer()
+				}
+			default:
+				{
+					r.Viewport, cmd = r.Viewport.Update(msg)
+				}
+			}
+		}
+	}
+
+	// Composer needs messages other than key presses too, e.g. to blink its cursor.
+	if _, ok := msg.(tea.KeyMsg); !ok && r.state == COMPOSE_REPLY {
+		var composerCmd tea.Cmd
+		r.Composer, composerCmd = r.Composer.Update(msg)
+		cmd = tea.Batch(cmd, composerCmd)
+	}
+
+	return r, cmd
+}
+
+func (r *ReviewThreadsScreen) View() string {
+	if r.state == COMPOSE_REPLY {
+		thread := r.selectedThread()
+		header := StyledHeader.Render(fmt.Sprintf("Reply to thread on %v", thread.GetPath()))
+
+		help, err := RenderHelp(REPLY_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+		if err != nil {
+			r.Logger.Error(err)
+		}
+
+		return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, header, r.Composer.View(), "", help))
+	}
+
+	title := "Review threads"
+	if r.pullRequest != nil {
+		title = fmt.Sprintf("Review threads of pull request #%v", r.pullRequest.GetNumber())
+	}
+	if r.threads != nil {
+		unresolved := 0
+		for _, thread := range r.threads {
+			if !thread.GetIsResolved() {
+				unresolved++
+			}
+		}
+		title += fmt.Sprintf(" · %v unresolved", unresolved)
+	}
+	header := StyledHeader.Render(title)
+
+	var content string
+	switch {
+	case r.threads == nil && r.err != nil:
+		content = StyledChangesRequested.Render(fmt.Sprintf("Could not fetch review threads: %v", r.err))
+	case r.threads == nil:
+		content = fmt.Sprintf("%v Loading review threads...", r.Spinner.View())
+	default:
+		content = r.Viewport.View()
+	}
+
+	status := ""
+	if r.pending {
+		status = fmt.Sprintf("%v Saving...", r.Spinner.View())
+	} else if r.threads != nil && r.err != nil {
+		status = StyledChangesRequested.Render(fmt.Sprintf("Could not update thread: %v", r.err))
+	}
+
+	help, err := RenderHelp(REVIEW_THREADS_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+	if err != nil {
+		r.Logger.Error(err)
+	}
+
+	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, header, content, status, help))
+}
@@ -3,13 +3,73 @@ package main
 import (
 	"encoding/json"
 	"os"
+	"strings"
+	"time"
 )
 
+// DEFAULT_MAX_PAGES limits how many pages of a single connection are fetched when max_pages is not configured.
+const DEFAULT_MAX_PAGES = 10
+
+// DEFAULT_REQUEST_TIMEOUT is the number of seconds a single attempt of a request can take when request_timeout is not
+// configured.
+const DEFAULT_REQUEST_TIMEOUT = 30
+
+// DEFAULT_MAX_RETRIES is the number of times failed requests are retried when max_retries is not configured.
+const DEFAULT_MAX_RETRIES = 3
+
+// Ways of displaying pull requests whose checks are failing.
+const (
+	FAILING_CHECKS_SHOW         = "show"
+	FAILING_CHECKS_DEPRIORITIZE = "deprioritize"
+	FAILING_CHECKS_HIDE         = "hide"
+)
+
+var FAILING_CHECKS_MODES = []string{FAILING_CHECKS_SHOW, FAILING_CHECKS_DEPRIORITIZE, FAILING_CHECKS_HIDE}
+
+// Orders in which pull requests can be listed.
+const (
+	SORT_MODE_STATE      = "state"
+	SORT_MODE_AGE        = "age"
+	SORT_MODE_UPDATED    = "updated"
+	SORT_MODE_REPOSITORY = "repository"
+	SORT_MODE_AUTHOR     = "author"
+	SORT_MODE_SIZE       = "size"
+)
+
+var SORT_MODES = []string{SORT_MODE_STATE, SORT_MODE_AGE, SORT_MODE_UPDATED, SORT_MODE_REPOSITORY, SORT_MODE_AUTHOR, SORT_MODE_SIZE}
+
+// Columns of the pull requests table.
+const (
+	COLUMN_REPOSITORY = "repository"
+	COLUMN_NUMBER     = "number"
+	COLUMN_TITLE      = "title"
+	COLUMN_AUTHOR     = "author"
+	COLUMN_AGE        = "age"
+	COLUMN_STATE      = "state"
+	COLUMN_CI         = "ci"
+	COLUMN_SIZE       = "size"
+	COLUMN_COMMENTS   = "comments"
+	COLUMN_REVIEWERS  = "reviewers"
+)
+
+var COLUMNS = []string{COLUMN_REPOSITORY, COLUMN_NUMBER, COLUMN_TITLE, COLUMN_AUTHOR, COLUMN_AGE, COLUMN_STATE, COLUMN_CI, COLUMN_SIZE, COLUMN_COMMENTS, COLUMN_REVIEWERS}
+
+var DEFAULT_COLUMNS = []string{COLUMN_REPOSITORY, COLUMN_NUMBER, COLUMN_TITLE, COLUMN_AUTHOR, COLUMN_AGE, COLUMN_STATE, COLUMN_CI, COLUMN_COMMENTS}
+
 type Settings struct {
-	GithubToken    string   `json:"github_token,omitempty"`
-	Username       string   `json:"username,omitempty"`
-	Repositories   []string `json:"repositories,omitempty"`
-	ConfigFilePath string
+	GithubToken       string   `json:"github_token,omitempty"`
+	Username          string   `json:"username,omitempty"`
+	Repositories      []string `json:"repositories,omitempty"`
+	MaxPages          int      `json:"max_pages,omitempty"`
+	RefreshInterval   int      `json:"refresh_interval,omitempty"`
+	RequestTimeout    int      `json:"request_timeout,omitempty"`
+	MaxRetries        int      `json:"max_retries,omitempty"`
+	Discovery         bool     `json:"discovery,omitempty"`
+	FailingChecks     string   `json:"failing_checks,omitempty"`
+	SortMode          string   `json:"sort_mode,omitempty"`
+	GroupByRepository bool     `json:"group_by_repository,omitempty"`
+	Columns           []string `json:"columns,omitempty"`
+	ConfigFilePath    string
 	*Logger
 }
 
@@ -26,7 +86,10 @@ func (r *Settings) Load() {
 	_, err := os.Stat(r.ConfigFilePath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			r.Save()
+			err = r.Save()
+			if err != nil {
+				panic(err)
+			}
 		} else {
 			r.Logger.Info("could not stat configuration file")
 			r.Logger.Error(err)
@@ -49,36 +112,183 @@ func (r *Settings) Load() {
 	r.Logger.Struct(r)
 }
 
-func (r *Settings) Save() {
+// Save writes settings to the configuration file. Settings stay changed in memory when they can not be written, so
+// callers only have to let the user know that they will be lost on exit.
+func (r *Settings) Save() error {
 	bytes, err := json.Marshal(r)
 	if err != nil {
-		r.Logger.Info("could not stat configuration file")
+		r.Logger.Info("could not marshal configuration")
 		r.Logger.Error(err)
-		panic(err)
+		return err
 	}
 
 	err = os.WriteFile(r.ConfigFilePath, bytes, 0644)
 	if err != nil {
-		panic(err)
+		r.Logger.Info("could not write configuration file")
+		r.Logger.Error(err)
+		return err
 	}
+
+	return nil
 }
 
-func (r *Settings) UpdateGitHubToken(token string) {
+func (r *Settings) GetMaxPages() int {
+	if r.MaxPages <= 0 {
+		return DEFAULT_MAX_PAGES
+	}
+
+	return r.MaxPages
+}
+
+func (r *Settings) GetRequestTimeout() time.Duration {
+	if r.RequestTimeout <= 0 {
+		return DEFAULT_REQUEST_TIMEOUT * time.Second
+	}
+
+	return time.Duration(r.RequestTimeout) * time.Second
+}
+
+// GetMaxRetries returns how many times failed requests are retried. Zero is the default, so negative values disable
+// retries.
+func (r *Settings) GetMaxRetries() int {
+	if r.MaxRetries == 0 {
+		return DEFAULT_MAX_RETRIES
+	}
+
+	if r.MaxRetries < 0 {
+		return 0
+	}
+
+	return r.MaxRetries
+}
+
+func (r *Settings) UpdateGitHubToken(token string) error {
 	r.GithubToken = token
-	r.Save()
+	return r.Save()
 }
 
-func (r *Settings) UpdateUsername(username string) {
+func (r *Settings) UpdateUsername(username string) error {
 	r.Username = username
-	r.Save()
+	return r.Save()
+}
+
+// UpdateRefreshInterval sets the number of seconds between background refreshes of pull requests. Zero disables them.
+func (r *Settings) UpdateRefreshInterval(seconds int) error {
+	r.RefreshInterval = seconds
+	return r.Save()
+}
+
+// ToggleDiscovery switches searching for review requests across all repositories, including the ones that are not
+// watched.
+func (r *Settings) ToggleDiscovery() error {
+	r.Discovery = !r.Discovery
+	return r.Save()
+}
+
+func (r *Settings) GetFailingChecks() string {
+	if r.FailingChecks == "" {
+		return FAILING_CHECKS_SHOW
+	}
+
+	return r.FailingChecks
+}
+
+// SwitchFailingChecks switches to the next way of displaying pull requests whose checks are failing.
+func (r *Settings) SwitchFailingChecks() error {
+	// Values that are not known, e.g. mistyped in the configuration file, are replaced with the first mode.
+	next := FAILING_CHECKS_MODES[0]
+	current := r.GetFailingChecks()
+	for i, mode := range FAILING_CHECKS_MODES {
+		if mode == current {
+			next = FAILING_CHECKS_MODES[(i+1)%len(FAILING_CHECKS_MODES)]
+		}
+	}
+
+	r.FailingChecks = next
+	return r.Save()
+}
+
+func (r *Settings) ToggleGroupByRepository() error {
+	r.GroupByRepository = !r.GroupByRepository
+	return r.Save()
+}
+
+func (r *Settings) GetSortMode() string {
+	if r.SortMode == "" {
+		return SORT_MODE_STATE
+	}
+
+	return r.SortMode
+}
+
+// SwitchSortMode switches to the next order in which pull requests are listed.
+func (r *Settings) SwitchSortMode() error {
+	// Values that are not known, e.g. mistyped in the configuration file, are replaced with the first mode.
+	next := SORT_MODES[0]
+	current := r.GetSortMode()
+	for i, mode := range SORT_MODES {
+		if mode == current {
+			next = SORT_MODES[(i+1)%len(SORT_MODES)]
+		}
+	}
+
+	r.SortMode = next
+	return r.Save()
+}
+
+func (r *Settings) GetColumns() []string {
+	if len(r.Columns) == 0 {
+		return DEFAULT_COLUMNS
+	}
+
+	return r.Columns
+}
+
+func (r *Settings) IsColumnVisible(column string) bool {
+	for _, visibleColumn := range r.GetColumns() {
+		if visibleColumn == column {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToggleColumn shows or hides the column of the pull requests table. The last visible column can not be hidden.
+func (r *Settings) ToggleColumn(column string) error {
+	var columns []string
+	for _, c := range COLUMNS {
+		if (c == column) != r.IsColumnVisible(c) {
+			columns = append(columns, c)
+		}
+	}
+
+	if len(columns) == 0 {
+		return nil
+	}
+
+	r.Columns = columns
+	return r.Save()
+}
+
+// IsWatchedRepository reports whether the repository url is on the list of watched repositories. Urls are compared
+// case-insensitively and without trailing slashes, since they are typed by hand.
+func (r *Settings) IsWatchedRepository(repositoryUrl string) bool {
+	for _, url := range r.Repositories {
+		if strings.EqualFold(strings.TrimSuffix(url, "/"), strings.TrimSuffix(repositoryUrl, "/")) {
+			return true
+		}
+	}
+
+	return false
 }
 
-func (r *Settings) AddRepositoryUrl(repositoryUrl string) {
+func (r *Settings) AddRepositoryUrl(repositoryUrl string) error {
 	r.Repositories = append(r.Repositories, repositoryUrl)
-	r.Save()
+	return r.Save()
 }
 
-func (r *Settings) DeleteRepositoryUrl(repositoryUrl string) {
+func (r *Settings) DeleteRepositoryUrl(repositoryUrl string) error {
 	var updatedRepositories []string
 	for _, url := range r.Repositories {
 		if url == repositoryUrl {
@@ -89,4 +299,5 @@ func (r *Settings) DeleteRepositoryUrl(repositoryUrl string) {
 	}
 
 	r.Repositories = updatedRepositories
+	return r.Save()
 }
@@ -5,25 +5,31 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/muesli/reflow/wordwrap"
 	"math"
-	"os/exec"
-	"runtime"
+	"time"
 )
 
 const (
 	UPDATE_GITHUB_TOKEN       string = "UPDATE_GITHUB_TOKEN"
 	ADD_GITHUB_REPOSITORY_URL string = "ADD_GITHUB_REPOSITORY_URL"
 	UPDATE_USERNAME           string = "UPDATE_USERNAME"
+	UPDATE_REFRESH_INTERVAL   string = "UPDATE_REFRESH_INTERVAL"
+	SELECT_COLUMNS            string = "SELECT_COLUMNS"
 	DEFAULT                   string = "DEFAULT"
 )
 
-var SETTINGS_HELP = []Help{helpUp, helpDown, helpQuit, helpAddGitHubRepositoryUrl, helpDeleteGitHubRepositoryUrl, helpOpenGitHubRepositoryUrl, helpUpdateGithubToken, helpSwitchToPullRequestsScreen, helpUpdateUsername}
+var SETTINGS_HELP = []Help{helpUp, helpDown, helpQuit, helpAddGitHubRepositoryUrl, helpDeleteGitHubRepositoryUrl, helpOpenGitHubRepositoryUrl, helpUpdateGithubToken, helpSwitchToPullRequestsScreen, helpUpdateUsername, helpUpdateRefreshInterval, helpToggleDiscovery, helpSwitchFailingChecks, helpSelectColumns, helpPageUp, helpPageDown, helpGoToTop, helpGoToBottom}
+
+var COLUMNS_HELP = []Help{helpUp, helpDown, helpToggleColumn, helpEscape}
 
 type SettingsScreen struct {
 	TextInput               textinput.Model
 	state                   string
 	SelectedRepositoryIndex int
+	SelectedColumnIndex     int
+	ListViewport            *ListViewport
+	viewerLogin             string
+	repositoryErrors        map[string]error
 	*Window
 	*Settings
 	*Logger
@@ -41,6 +47,7 @@ func NewSettingsScreen(globalState *Window, settings *Settings, logger *Logger,
 		TextInput:               textInput,
 		state:                   DEFAULT,
 		SelectedRepositoryIndex: 0,
+		ListViewport:            NewListViewport(),
 		Window:                  globalState,
 		Settings:                settings,
 		Logger:                  logger,
@@ -48,18 +55,111 @@ func NewSettingsScreen(globalState *Window, settings *Settings, logger *Logger,
 	}
 }
 
+// viewerFetchedMsg is emitted once the user authenticated with the GitHub token is known.
+type viewerFetchedMsg struct {
+	login string
+	err   error
+}
+
+// githubTokenUpdatedMsg is emitted when a new GitHub token is saved in the settings screen.
+type githubTokenUpdatedMsg struct{}
+
+func (r *SettingsScreen) fetchViewer() tea.Cmd {
+	if r.Settings.GithubToken == "" {
+		return nil
+	}
+
+	return func() tea.Msg {
+		response, err := getViewer(r.GithubApi.Context(), *r.GithubApi.client)
+		if err != nil {
+			return viewerFetchedMsg{err: err}
+		}
+
+		return viewerFetchedMsg{login: response.GetViewer().GetLogin()}
+	}
+}
+
+// updateColumnPicker moves through columns of the pull requests table and shows or hides them.
+func (r *SettingsScreen) updateColumnPicker(msg tea.KeyMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case helpDown.Shortcut:
+		{
+			r.SelectedColumnIndex = (r.SelectedColumnIndex + 1) % len(COLUMNS)
+		}
+	case helpUp.Shortcut:
+		{
+			r.SelectedColumnIndex = (r.SelectedColumnIndex - 1 + len(COLUMNS)) % len(COLUMNS)
+		}
+	case helpToggleColumn.Shortcut:
+		{
+			cmd = r.notifySaveError(r.Settings.ToggleColumn(COLUMNS[r.SelectedColumnIndex]), nil)
+		}
+	case helpEscape.Shortcut:
+		{
+			r.state = DEFAULT
+		}
+	}
+
+	return cmd
+}
+
+// notifySaveError lets the user know when changed settings could not be saved. Settings are changed in memory anyway,
+// so the given command is run regardless.
+func (r *SettingsScreen) notifySaveError(err error, cmd tea.Cmd) tea.Cmd {
+	if err != nil {
+		return tea.Batch(cmd, notifyError("could not save settings", err))
+	}
+
+	return cmd
+}
+
 func (r *SettingsScreen) Init() tea.Cmd {
-	return nil
+	return r.fetchViewer()
 }
 
 func (r *SettingsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
+	case viewerFetchedMsg:
+		{
+			if msg.err != nil {
+				r.Logger.Info("could not fetch user authenticated with github token")
+				r.Logger.Error(msg.err)
+
+				r.viewerLogin = ""
+				break
+			}
+
+			r.Logger.Info(fmt.Sprintf("authenticated as %v", msg.login))
+
+			r.viewerLogin = msg.login
+			if r.Settings.Username != msg.login {
+				cmd = r.notifySaveError(r.Settings.UpdateUsername(msg.login), nil)
+			}
+		}
+	case repositoryErrorsUpdatedMsg:
+		{
+			r.repositoryErrors = msg.errors
+		}
 	case tea.KeyMsg:
 		{
 			r.Logger.KeyPress(msg.String())
 
+			if r.state == SELECT_COLUMNS {
+				cmd = r.updateColumnPicker(msg)
+				break
+			}
+
+			if r.state == DEFAULT {
+				if index, ok := r.ListViewport.Navigate(msg.String(), r.SelectedRepositoryIndex, len(r.Settings.Repositories)); ok {
+					r.SelectedRepositoryIndex = index
+					break
+				}
+			}
+
 			switch msg.String() {
 			case helpDown.Shortcut:
 				{
@@ -79,7 +179,7 @@ func (r *SettingsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			case helpEscape.Shortcut:
 				{
-					if r.state == UPDATE_GITHUB_TOKEN || r.state == ADD_GITHUB_REPOSITORY_URL {
+					if r.state == UPDATE_GITHUB_TOKEN || r.state == ADD_GITHUB_REPOSITORY_URL || r.state == UPDATE_USERNAME || r.state == UPDATE_REFRESH_INTERVAL {
 						r.state = DEFAULT
 						r.TextInput.Reset()
 					}
@@ -96,9 +196,53 @@ func (r *SettingsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				{
 					r.state = UPDATE_USERNAME
 				}
+			case helpUpdateRefreshInterval.Shortcut:
+				{
+					// Ctrl + E moves the cursor to the end of the text input, so it only opens the form when no other
+					// form is open.
+					if r.state == DEFAULT {
+						r.state = UPDATE_REFRESH_INTERVAL
+					}
+				}
+			case helpToggleDiscovery.Shortcut:
+				{
+					// Ctrl + D deletes the character under the cursor of the text input.
+					if r.state != DEFAULT {
+						break
+					}
+
+					cmd = r.notifySaveError(r.Settings.ToggleDiscovery(), func() tea.Msg {
+						return discoveryToggledMsg{}
+					})
+				}
+			case helpSelectColumns.Shortcut:
+				{
+					// A value being typed would be left in the text input, neither saved nor discarded.
+					if r.state != DEFAULT {
+						break
+					}
+
+					r.state = SELECT_COLUMNS
+					r.SelectedColumnIndex = 0
+				}
+			case helpSwitchFailingChecks.Shortcut:
+				{
+					// Ctrl + F moves the cursor forward in the text input.
+					if r.state != DEFAULT {
+						break
+					}
+
+					cmd = r.notifySaveError(r.Settings.SwitchFailingChecks(), func() tea.Msg {
+						return failingChecksSwitchedMsg{}
+					})
+				}
 			case helpDeleteGitHubRepositoryUrl.Shortcut:
 				{
-					r.Settings.DeleteRepositoryUrl(r.Settings.Repositories[r.SelectedRepositoryIndex])
+					if len(r.Settings.Repositories) == 0 {
+						break
+					}
+
+					cmd = r.notifySaveError(r.Settings.DeleteRepositoryUrl(r.Settings.Repositories[r.SelectedRepositoryIndex]), nil)
 					r.SelectedRepositoryIndex = int(math.Max(float64(r.SelectedRepositoryIndex-1), float64(0)))
 				}
 			case helpOpenGitHubRepositoryUrl.Shortcut:
@@ -106,39 +250,30 @@ func (r *SettingsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					switch r.state {
 					case DEFAULT:
 						{
+							if len(r.Settings.Repositories) == 0 {
+								break
+							}
+
 							selectedRepository := r.Settings.Repositories[r.SelectedRepositoryIndex]
 
 							r.Logger.Info(fmt.Sprintf("opening a default browser on %v page", selectedRepository))
 
-							var err error
-							switch runtime.GOOS {
-							case "linux":
-								{
-									err = exec.Command("xdg-open", selectedRepository).Start()
-								}
-							case "windows":
-								{
-									err = exec.Command("rundll32", "url.dll,FileProtocolHandler", selectedRepository).Start()
-								}
-							case "darwin":
-								{
-									err = exec.Command("open", selectedRepository).Start()
-								}
-							default:
-								err = fmt.Errorf("unsupported platform %v", runtime.GOOS)
-							}
-
-							if err != nil {
-								panic(err)
+							if err := openUrl(selectedRepository); err != nil {
+								cmd = notifyError("could not open repository in browser", err)
 							}
 						}
 					case UPDATE_GITHUB_TOKEN:
 						{
 							r.Logger.Info(fmt.Sprintf("current input value %v", r.TextInput.Value()))
 
-							r.Settings.UpdateGitHubToken(r.TextInput.Value())
+							err := r.Settings.UpdateGitHubToken(r.TextInput.Value())
 							r.GithubApi.UpdateClient(r.TextInput.Value())
 
+							r.viewerLogin = ""
+							cmd = r.notifySaveError(err, tea.Batch(r.fetchViewer(), func() tea.Msg {
+								return githubTokenUpdatedMsg{}
+							}))
+
 							if r.TextInput.Value() != "" {
 								r.TextInput.Reset()
 							}
@@ -149,7 +284,7 @@ func (r *SettingsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						{
 							r.Logger.Info(fmt.Sprintf("current input value %v", r.TextInput.Value()))
 
-							r.Settings.AddRepositoryUrl(r.TextInput.Value())
+							cmd = r.notifySaveError(r.Settings.AddRepositoryUrl(r.TextInput.Value()), nil)
 
 							if r.TextInput.Value() != "" {
 								r.SelectedRepositoryIndex = len(r.Repositories) - 1
@@ -162,7 +297,29 @@ func (r *SettingsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						{
 							r.Logger.Info(fmt.Sprintf("current input value %v", r.TextInput.Value()))
 
-							r.Settings.UpdateUsername(r.TextInput.Value())
+							cmd = r.notifySaveError(r.Settings.UpdateUsername(r.TextInput.Value()), nil)
+
+							r.TextInput.Reset()
+
+							r.state = DEFAULT
+						}
+					case UPDATE_REFRESH_INTERVAL:
+						{
+							r.Logger.Info(fmt.Sprintf("current input value %v", r.TextInput.Value()))
+
+							interval, err := time.ParseDuration(r.TextInput.Value())
+							if err != nil {
+								cmd = notify(TOAST_WARNING, fmt.Sprintf("could not parse refresh interval %q, use e.g. 30s or 5m", r.TextInput.Value()))
+							} else {
+								message := fmt.Sprintf("pull requests will be refreshed every %v", interval)
+								if interval.Seconds() < 1 {
+									message = "pull requests will not be refreshed in the background"
+								}
+
+								cmd = r.notifySaveError(r.Settings.UpdateRefreshInterval(int(interval.Seconds())), tea.Batch(notify(TOAST_INFO, message), func() tea.Msg {
+									return refreshIntervalUpdatedMsg{}
+								}))
+							}
 
 							r.TextInput.Reset()
 
@@ -175,8 +332,10 @@ func (r *SettingsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	if r.state == UPDATE_GITHUB_TOKEN || r.state == ADD_GITHUB_REPOSITORY_URL || r.state == UPDATE_USERNAME {
-		r.TextInput, cmd = r.TextInput.Update(msg)
+	if r.state == UPDATE_GITHUB_TOKEN || r.state == ADD_GITHUB_REPOSITORY_URL || r.state == UPDATE_USERNAME || r.state == UPDATE_REFRESH_INTERVAL {
+		var textInputCmd tea.Cmd
+		r.TextInput, textInputCmd = r.TextInput.Update(msg)
+		cmd = tea.Batch(cmd, textInputCmd)
 	}
 
 	return r, cmd
@@ -205,31 +364,114 @@ func (r *SettingsScreen) View() string {
 			"(esc to quit)") + "\n")
 	}
 
-	repositories := ""
+	if r.state == UPDATE_REFRESH_INTERVAL {
+		return StyledMain.Render(fmt.Sprintf(
+			"Type how often pull requests should be refreshed (e.g. 30s, 5m, 0 to disable):\n\n%s\n\n%s",
+			r.TextInput.View(),
+			"(esc to quit)") + "\n")
+	}
+
+	if r.state == SELECT_COLUMNS {
+		return r.columnsView()
+	}
+
+	layout := r.layout()
+	list := r.ListViewport.View(layout.repositories, r.SelectedRepositoryIndex, r.Window.Width-StyledMain.GetHorizontalPadding(), layout.listHeight(r.Window.Height))
+
+	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, StyledHeader.Render(layout.header), list, layout.options, "", layout.help))
+}
+
+// settingsLayout holds parts of the settings screen, so that the same repositories are laid out in the list viewport
+// while handling messages and rendered by View.
+type settingsLayout struct {
+	header       string
+	options      string
+	help         string
+	repositories []string
+}
+
+// listHeight returns how many repositories fit in the space left by other elements. One line is reserved for the
+// scroll indicator.
+func (r *settingsLayout) listHeight(windowHeight int) int {
+	return windowHeight - StyledMain.GetVerticalPadding() - lipgloss.Height(StyledHeader.Render(r.header)) - lipgloss.Height(r.options) - 1 - lipgloss.Height(r.help) - 1
+}
+
+func (r *SettingsScreen) layout() settingsLayout {
+	var repositories []string
 
 	s := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
 	x := lipgloss.NewStyle().Underline(true)
 	for index, url := range r.Settings.Repositories {
+		repository := s.Render(url)
 		if index == r.SelectedRepositoryIndex {
-			repositories += x.Render(url)
-			repositories += "\n"
-		} else {
-			repositories += s.Render(url)
-			repositories += "\n"
+			repository = x.Render(url)
 		}
+
+		// Repositories that could not be fetched the last time are marked with the reason.
+		if err := r.repositoryErrors[url]; err != nil {
+			repository += " " + StyledChangesRequested.Render(fmt.Sprintf("✗ %v", ClassifyError(err)))
+		}
+
+		repositories = append(repositories, repository)
+	}
+
+	header := "Settings · not authenticated"
+	if r.viewerLogin != "" {
+		header = fmt.Sprintf("Settings · authenticated as @%v", r.viewerLogin)
 	}
 
-	helpString := ""
-	for _, help := range SETTINGS_HELP {
-		helpString += lipgloss.JoinHorizontal(lipgloss.Left, StyledHelpShortcut.Render(help.Display), " ", StyledHelpDescription.Render(help.Description), "   ")
+	discovery := "off"
+	if r.Settings.Discovery {
+		discovery = "on"
+	}
+	options := StyledHelpDescription.Render(fmt.Sprintf("Discovery of review requests and my pull requests in unwatched repositories: %v", discovery))
+	options += "\n" + StyledHelpDescription.Render(fmt.Sprintf("Pull requests with failing checks: %v", r.Settings.GetFailingChecks()))
+
+	help, err := RenderHelp(SETTINGS_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
+	if err != nil {
+		r.Logger.Error(err)
+	}
+
+	return settingsLayout{
+		header:       header,
+		options:      options,
+		help:         help,
+		repositories: repositories,
+	}
+}
+
+// layoutList scrolls the list to the selected repository. The router calls it after messages handled while the screen
+// is displayed, because any of them may change repositories or the space they have.
+func (r *SettingsScreen) layoutList() {
+	// Forms take the whole screen, so the list is not displayed.
+	if r.state != DEFAULT {
+		return
+	}
+
+	layout := r.layout()
+	r.ListViewport.Layout(len(layout.repositories), r.SelectedRepositoryIndex, layout.listHeight(r.Window.Height))
+}
+
+func (r *SettingsScreen) columnsView() string {
+	columns := ""
+	for i, column := range COLUMNS {
+		checkbox := "[ ]"
+		if r.Settings.IsColumnVisible(column) {
+			checkbox = "[x]"
+		}
+
+		line := fmt.Sprintf("%v %v", checkbox, column)
+		if i == r.SelectedColumnIndex {
+			columns += StyledUnderline.Render(line) + "\n"
+		} else {
+			columns += line + "\n"
+		}
 	}
 
-	wrapper := wordwrap.NewWriter(r.Window.Width - StyledMain.GetHorizontalPadding())
-	wrapper.Breakpoints = []rune{' '}
-	_, err := wrapper.Write([]byte(helpString))
+	help, err := RenderHelp(COLUMNS_HELP, r.Window.Width-StyledMain.GetHorizontalPadding())
 	if err != nil {
 		r.Logger.Error(err)
 	}
 
-	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, StyledHeader.Render("Settings"), repositories, wrapper.String()))
+	return StyledMain.Render(lipgloss.JoinVertical(lipgloss.Left, StyledHeader.Render("Columns of pull requests table"), columns, help))
 }
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// STATUS_BAR_HEIGHT is taken from the window before screens lay themselves out, so that the status bar always fits.
+const STATUS_BAR_HEIGHT = 1
+
+// renderStatusBar describes how much of the GitHub API budget is left and whether requests are held back because of it.
+func renderStatusBar(rateLimiter *RateLimiter, now time.Time) string {
+	if backOffUntil := rateLimiter.GetBackOffUntil(now); !backOffUntil.IsZero() {
+		return StyledChangesRequested.Render(fmt.Sprintf("GitHub rate limit exceeded, requests are paused until %v", backOffUntil.Local().Format(time.Kitchen)))
+	}
+
+	rateLimit := rateLimiter.GetRateLimit()
+	if rateLimit == nil {
+		return StyledHelpDescription.Render("GitHub API budget is not known yet")
+	}
+
+	budget := fmt.Sprintf("GitHub API budget: %v/%v left", rateLimit.GetRemaining(), rateLimit.GetLimit())
+	resetAt := rateLimit.GetResetAt().Local().Format(time.Kitchen)
+
+	if postponedUntil := rateLimiter.PostponedUntil(now); !postponedUntil.IsZero() {
+		return StyledNewCommits.Render(fmt.Sprintf("%v, refreshes are postponed until %v", budget, resetAt))
+	}
+
+	return StyledHelpDescription.Render(fmt.Sprintf("%v, last query cost %v, resets at %v", budget, rateLimit.GetCost(), resetAt))
+}
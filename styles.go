@@ -10,7 +10,10 @@ var ColorLimeGreen = lipgloss.Color("#32CD32")
 var ColorDeepSkyBlue = lipgloss.Color("#00BFFF")
 var ColorGrey = lipgloss.Color("#808080")
 var ColorWhite = lipgloss.Color("#FFFFFF")
+var ColorBlack = lipgloss.Color("#000000")
 var ColorGold = lipgloss.Color("#FFD700")
+var ColorMediumPurple = lipgloss.Color("#9370DB")
+var ColorDarkOrange = lipgloss.Color("#FF8C00")
 
 var StyledMain = lipgloss.NewStyle().PaddingTop(1).PaddingBottom(1).PaddingLeft(2).PaddingRight(2)
 var StyledHeader = lipgloss.NewStyle().AlignHorizontal(lipgloss.Center).Bold(true).Border(lipgloss.RoundedBorder()).BorderForeground(ColorDeepPink)
@@ -18,10 +21,15 @@ var StyledHeader = lipgloss.NewStyle().AlignHorizontal(lipgloss.Center).Bold(tru
 var StyledChangesRequested = lipgloss.NewStyle().Foreground(ColorOrangeRed)
 var StyledApproved = lipgloss.NewStyle().Foreground(ColorLimeGreen)
 var StyledAwaiting = lipgloss.NewStyle().Foreground(ColorDeepSkyBlue)
+var StyledTeamAwaiting = lipgloss.NewStyle().Foreground(ColorMediumPurple)
+var StyledNewCommits = lipgloss.NewStyle().Foreground(ColorDarkOrange)
 var StyledDraft = lipgloss.NewStyle().Foreground(ColorGrey)
 var StyledCommented = lipgloss.NewStyle().Foreground(ColorGold)
+var StyledUnwatched = lipgloss.NewStyle().Foreground(ColorGrey).Italic(true)
 
 var StyledHelpShortcut = lipgloss.NewStyle().Foreground(ColorWhite)
 var StyledHelpDescription = lipgloss.NewStyle().Foreground(ColorGrey)
 
+var StyledSpinner = lipgloss.NewStyle().Foreground(ColorDeepPink)
+
 var StyledUnderline = lipgloss.NewStyle().Underline(true)
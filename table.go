@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TABLE_MIN_FLEXIBLE_WIDTH keeps flexible columns readable when fixed columns take most of the window.
+const TABLE_MIN_FLEXIBLE_WIDTH = 10
+
+// Column describes a column of the pull requests table. Columns without width take the space left by other columns.
+type Column struct {
+	Title string
+	Width int
+}
+
+var COLUMN_DEFINITIONS = map[string]Column{
+	COLUMN_REPOSITORY: {Title: "Repository", Width: 20},
+	COLUMN_NUMBER:     {Title: "#", Width: 6},
+	COLUMN_TITLE:      {Title: "Title"},
+	COLUMN_AUTHOR:     {Title: "Author", Width: 14},
+	COLUMN_AGE:        {Title: "Age", Width: 4},
+	COLUMN_STATE:      {Title: "State", Width: 17},
+	COLUMN_CI:         {Title: "CI", Width: 2},
+	COLUMN_SIZE:       {Title: "Size", Width: 11},
+	COLUMN_COMMENTS:   {Title: "Comments", Width: 8},
+	COLUMN_REVIEWERS:  {Title: "Reviewers", Width: 24},
+}
+
+// getColumnWidths distributes the width between columns. Columns are separated with a single space.
+func getColumnWidths(columns []string, width int) []int {
+	widths := make([]int, len(columns))
+	remaining := width - (len(columns) - 1)
+	flexible := 0
+
+	for i, column := range columns {
+		widths[i] = COLUMN_DEFINITIONS[column].Width
+		remaining -= widths[i]
+		if widths[i] == 0 {
+			flexible++
+		}
+	}
+
+	for i := range widths {
+		if widths[i] == 0 {
+			widths[i] = remaining / flexible
+			if widths[i] < TABLE_MIN_FLEXIBLE_WIDTH {
+				widths[i] = TABLE_MIN_FLEXIBLE_WIDTH
+			}
+		}
+	}
+
+	return widths
+}
+
+// renderTableRow truncates or pads every cell to the width of its column. Rows wider than the window are truncated.
+func renderTableRow(cells []string, widths []int, width int) string {
+	var fitted []string
+	for i, cell := range cells {
+		fitted = append(fitted, fitWidth(cell, widths[i]))
+	}
+
+	return fitWidth(strings.Join(fitted, " "), width)
+}
+
+// formatAge shortens the duration to its largest unit, e.g. 3d or 5h.
+func formatAge(age time.Duration) string {
+	switch {
+	case age >= 365*24*time.Hour:
+		return fmt.Sprintf("%vy", int(age.Hours()/24/365))
+	case age >= 24*time.Hour:
+		return fmt.Sprintf("%vd", int(age.Hours()/24))
+	case age >= time.Hour:
+		return fmt.Sprintf("%vh", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%vm", int(age.Minutes()))
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetColumnWidths(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		width   int
+		want    []int
+	}{
+		{
+			name:    "flexible column takes the remaining space",
+			columns: []string{COLUMN_NUMBER, COLUMN_TITLE, COLUMN_AUTHOR},
+			width:   60,
+			want:    []int{6, 38, 14},
+		},
+		{
+			name:    "single flexible column",
+			columns: []string{COLUMN_TITLE},
+			width:   50,
+			want:    []int{50},
+		},
+		{
+			name:    "fixed columns only",
+			columns: []string{COLUMN_NUMBER, COLUMN_AGE},
+			width:   100,
+			want:    []int{6, 4},
+		},
+		{
+			name:    "flexible column keeps the minimum width",
+			columns: []string{COLUMN_REPOSITORY, COLUMN_TITLE},
+			width:   25,
+			want:    []int{20, TABLE_MIN_FLEXIBLE_WIDTH},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := getColumnWidths(test.columns, test.width)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("getColumnWidths() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		age  time.Duration
+		want string
+	}{
+		{age: 30 * time.Second, want: "0m"},
+		{age: 59 * time.Minute, want: "59m"},
+		{age: time.Hour, want: "1h"},
+		{age: 23*time.Hour + 59*time.Minute, want: "23h"},
+		{age: 47 * time.Hour, want: "1d"},
+		{age: 364 * 24 * time.Hour, want: "364d"},
+		{age: 800 * 24 * time.Hour, want: "2y"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			if got := formatAge(test.age); got != test.want {
+				t.Errorf("formatAge(%v) = %v, want %v", test.age, got, test.want)
+			}
+		})
+	}
+}
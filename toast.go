@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"github.com/charmbracelet/lipgloss"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Levels of toasts.
+const (
+	TOAST_INFO    = "info"
+	TOAST_WARNING = "warning"
+	TOAST_ERROR   = "error"
+)
+
+// TOAST_DURATIONS tells how long toasts of each level are shown. Errors stay longer, so that there is time to read them.
+var TOAST_DURATIONS = map[string]time.Duration{
+	TOAST_INFO:    3 * time.Second,
+	TOAST_WARNING: 5 * time.Second,
+	TOAST_ERROR:   8 * time.Second,
+}
+
+var TOAST_STYLES = map[string]lipgloss.Style{
+	TOAST_INFO:    StyledAwaiting,
+	TOAST_WARNING: StyledNewCommits,
+	TOAST_ERROR:   StyledChangesRequested,
+}
+
+var TOAST_ICONS = map[string]string{
+	TOAST_INFO:    "ℹ",
+	TOAST_WARNING: "⚠",
+	TOAST_ERROR:   "✗",
+}
+
+// Toast is a short notification displayed by the router in the status line, on top of any screen.
+type Toast struct {
+	id      int
+	level   string
+	message string
+}
+
+func (r *Toast) View() string {
+	return TOAST_STYLES[r.level].Render(fmt.Sprintf("%v %v", TOAST_ICONS[r.level], r.message))
+}
+
+// toastMsg asks the router to show a toast. Any screen can emit it with notify.
+type toastMsg struct {
+	level   string
+	message string
+}
+
+// toastExpiredMsg hides the toast with the given id once it has been shown long enough.
+type toastExpiredMsg struct {
+	id int
+}
+
+func notify(level string, message string) tea.Cmd {
+	return func() tea.Msg {
+		return toastMsg{
+			level:   level,
+			message: message,
+		}
+	}
+}
+
+// notifyError shows what could not be done together with the reason. The router logs it like any other toast.
+func notifyError(message string, err error) tea.Cmd {
+	return notify(TOAST_ERROR, fmt.Sprintf("%v: %v", message, err))
+}